@@ -18,7 +18,10 @@ import (
 	"github.com/kuhree/gg/examples/sorts"
 	"github.com/kuhree/gg/examples/spaceinvaders"
 	"github.com/kuhree/gg/internal/engine/core"
+	"github.com/kuhree/gg/internal/engine/render"
+	"github.com/kuhree/gg/internal/engine/session"
 	"github.com/kuhree/gg/internal/utils"
+	"golang.org/x/term"
 )
 
 // Game settings
@@ -31,6 +34,9 @@ var (
 	// Game engine settings
 	time    float64
 	workDir string
+	record  string
+	theme   string
+	scale   string
 
 	// Debug settings
 	debug   bool
@@ -58,6 +64,9 @@ func init() {
 	flag.IntVar(&height, "height", 24, "height of the game")
 	flag.Float64Var(&time, "time", 1.0, "target time elapse within game")
 	flag.Float64Var(&fps, "fps", 60, "target fps within game (24,30,60,120,240)")
+	flag.StringVar(&record, "record", "", "Record the session as an asciicast v2 file")
+	flag.StringVar(&scale, "scale", "fit", "What to do in a terminal smaller than the game: fit (scale down) or none (ask to enlarge)")
+	flag.StringVar(&theme, "theme", render.DefaultTheme.Name, "Color theme (default, colorblind, monochrome or a file in <workDir>/themes)")
 
 	flag.BoolVar(&overlay, "overlay", false, "Enable some useful overlays")
 	flag.BoolVar(&debug, "debug", false, "Enable Debug logging. Will enable all other debug attributes.")
@@ -91,6 +100,20 @@ func main() {
 		}
 	}
 
+	activeTheme, err := render.LoadTheme(workDir, theme)
+	if err != nil {
+		utils.Logger.Error("Failed to load theme", "theme", theme, "error", err)
+		os.Exit(1)
+	}
+	render.UseTheme(activeTheme)
+
+	scaleMode, ok := render.ScaleModes[scale]
+	if !ok {
+		utils.Logger.Error("Unknown scale mode", "scale", scale)
+		os.Exit(1)
+	}
+	render.SetScaleMode(scaleMode)
+
 	utils.Logger.Info("Starting GG", "debug", debug)
 	defer func() {
 		_ = utils.Cleanup()
@@ -130,8 +153,21 @@ func launchSelectedGame(launcher Launcher) {
 		os.Exit(1)
 	}
 
+	stopSession := func() {}
+	if publisher, ok := game.(core.Publisher); ok {
+		stopSession = session.Record(publisher.EventBus(), utils.Logger).Stop
+	}
+
 	gl := core.NewGameLoop(game)
+	stopRecording, err := startRecording(gl)
+	if err != nil {
+		utils.Logger.Error("Failed to start recording", "error", err)
+		os.Exit(1)
+	}
+
 	err = gl.Run(time, fps)
+	stopRecording()
+	stopSession()
 	if err != nil {
 		if err == core.ErrQuitGame {
 			utils.Logger.Warn("Quit game!", "error", err)
@@ -149,6 +185,44 @@ func launchSelectedGame(launcher Launcher) {
 	}
 }
 
+// startRecording tees the renderer output into the --record file, stamped
+// with the game loop's clock. The returned func finishes the recording.
+func startRecording(gl *core.GameLoop) (func(), error) {
+	if record == "" {
+		return func() {}, nil
+	}
+
+	file, err := os.Create(record)
+	if err != nil {
+		return nil, err
+	}
+
+	// Frames are letterboxed to the terminal, so record at its size
+	castWidth, castHeight := width, height
+	if w, h, err := term.GetSize(int(os.Stdout.Fd())); err == nil {
+		castWidth, castHeight = max(w, width), max(h, height)
+	}
+
+	recorder, err := render.NewRecorder(file, os.Stdout, castWidth, castHeight)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	recorder.SetClock(gl.Elapsed)
+	render.SetOutput(recorder)
+	utils.Logger.Info("Recording session", "path", record)
+
+	return func() {
+		render.SetOutput(os.Stdout)
+		if err := recorder.Err(); err != nil {
+			utils.Logger.Error("Recording failed", "path", record, "error", err)
+		}
+		if err := file.Close(); err != nil {
+			utils.Logger.Error("Failed to close recording", "path", record, "error", err)
+		}
+	}, nil
+}
+
 func launchGame(gameName string) {
 	utils.Logger.Info("Launching game", "name", gameName)
 
@@ -173,6 +247,7 @@ func launchGame(gameName string) {
 func showGameMenu() {
 	utils.Logger.Info("Showing game selection menu")
 
+	printBanner()
 	for i, game := range games {
 		fmt.Printf("%d. %s: %s\n", i+1, game.Name, game.Description)
 	}
@@ -209,6 +284,18 @@ func showGameMenu() {
 	}
 }
 
+// printBanner prints the launcher's title art in the current theme
+func printBanner() {
+	const title = "GG"
+
+	width, height := render.BlockFont.Measure(title)
+	renderer := render.NewRenderer(width, height, render.DefaultPalette)
+	gradient := render.Gradient{renderer.Color(render.RoleTitle), renderer.Color(render.RoleAccent)}
+	_ = renderer.DrawBanner(title, 0, 0, render.BlockFont, gradient)
+	_ = renderer.ExportANSI(os.Stdout)
+	fmt.Println()
+}
+
 var games = []Launcher{
 	{
 		"Frames",
@@ -5,9 +5,12 @@ import (
 	"log/slog"
 
 	"github.com/kuhree/gg/internal/engine/core"
+	"github.com/kuhree/gg/internal/engine/events"
 	"github.com/kuhree/gg/internal/engine/leaderboard"
+	"github.com/kuhree/gg/internal/engine/menus"
 	"github.com/kuhree/gg/internal/engine/render"
 	"github.com/kuhree/gg/internal/engine/scenes"
+	"github.com/kuhree/gg/internal/engine/timing"
 	"github.com/kuhree/gg/internal/utils"
 )
 
@@ -18,6 +21,13 @@ const (
 	GameOverSceneID
 )
 
+// Transitions into gameplay, out of it and back to the menu
+var (
+	startTransition    = scenes.Transition{Style: scenes.WipeDown, Duration: 0.6, Easing: timing.EaseInOut}
+	gameOverTransition = scenes.Transition{Style: scenes.Dissolve, Duration: 0.8, Easing: timing.EaseIn}
+	menuTransition     = scenes.Transition{Style: scenes.Fade, Duration: 0.5}
+)
+
 // Game represents the Space Invaders game state and logic
 
 type Game struct {
@@ -34,9 +44,8 @@ type Game struct {
 	Debug   bool
 	Overlay bool
 
-	// Game-specific state
-	Score        int
-	CurrentLevel int
+	// Score and level, every change is published on the event bus
+	events.Stats
 }
 
 // NewGame creates a new instance of the game
@@ -64,6 +73,7 @@ func NewGame(width, height int, workDir string, debug bool, overlay bool) (*Game
 		Height:      height,
 		Width:       width,
 		Renderer:    renderer,
+		Stats:       events.NewStats(),
 		Logger:      logger,
 		Config:      config,
 		Leaderboard: board,
@@ -80,17 +90,56 @@ func (g *Game) Init() error {
 	g.Logger.Info(fmt.Sprintf("%s - Game initializing...", g.Config.Title))
 
 	g.Logger.Info(fmt.Sprintf("%s - Adding Scenes", g.Config.Title))
-	g.Scenes.AddScene(MainMenuSceneID, NewMainMenuScene(g))
+	ctx := menus.Context{Title: g.Config.Title, Scenes: g.Scenes, Logger: g.Logger}
+	g.Scenes.AddScene(MainMenuSceneID, menus.NewMainMenu(ctx, menus.MainMenuConfig{
+		Controls: []string{
+			"ESC to pause",
+			"Q to pause/quit",
+		},
+		Start:      g.newGame,
+		Next:       PlayingSceneID,
+		Transition: startTransition,
+	}))
 	g.Scenes.AddScene(PlayingSceneID, NewPlayingScene(g))
-	g.Scenes.AddScene(GameOverSceneID, NewGameOverScene(g))
-	g.Scenes.AddScene(PauseMenuSceneID, NewPauseMenuScene(g))
-	g.Scenes.ChangeScene(MainMenuSceneID)
+	g.Scenes.AddScene(GameOverSceneID, menus.NewGameOver(ctx, menus.GameOverConfig{
+		Leaderboard:     g.Leaderboard,
+		BoardFile:       g.Config.BoardFile,
+		LeaderboardSize: g.Config.LeaderboardSize,
+		MaxNameLength:   g.Config.MaxNameLength,
+		Score:           func() int { return g.Score },
+		Details:         g.details,
+		Next:            MainMenuSceneID,
+		Transition:      menuTransition,
+	}))
+	g.Scenes.AddScene(PauseMenuSceneID, menus.NewPauseMenu(ctx, menus.PauseMenuConfig{
+		Status: func() string { return fmt.Sprintf("Score: %d | Level: %d", g.Score, g.CurrentLevel) },
+	}))
+	if err := g.Scenes.ChangeScene(MainMenuSceneID); err != nil {
+		return err
+	}
 	g.Logger.Info(fmt.Sprintf("%s - Scenes loaded!", g.Config.Title), "startScene", MainMenuSceneID)
 
 	g.Logger.Info(fmt.Sprintf("%s - Game initialized", g.Config.Title))
 	return nil
 }
 
+// newGame resets the score and level and starts from a fresh playing scene
+func (g *Game) newGame() error {
+	g.Start(g.Config.Title, 0, 0)
+	g.Scenes.AddScene(PlayingSceneID, NewPlayingScene(g))
+	return nil
+}
+
+// details describes the settings a score was reached with, saved alongside it
+func (g *Game) details() string {
+	width, height := g.Size()
+	return fmt.Sprintf(
+		"%dW*%dH|L%d",
+		width, height,
+		g.CurrentLevel,
+	)
+}
+
 // Cleanup performs any necessary cleanup
 func (g *Game) Cleanup() {
 	if g.Score > 0 {
@@ -105,6 +154,11 @@ func (g *Game) Cleanup() {
 	g.Logger.Info(fmt.Sprintf("%s - Game cleaned up", g.Config.Title))
 }
 
+// Screenshot saves the last rendered frame into the game directory
+func (g *Game) Screenshot() ([]string, error) {
+	return g.Renderer.SaveScreenshot(g.Config.GameDir)
+}
+
 func (g *Game) Size() (int, int) {
 	return g.Width, g.Height
 }
@@ -113,13 +167,14 @@ func (g *Game) Size() (int, int) {
 func (g *Game) Draw() {
 	g.Renderer.Clear()
 	g.Scenes.Draw(g.Renderer)
-	g.Renderer.Render()
+	if err := g.Renderer.Render(); err != nil {
+		g.Logger.Error(fmt.Sprintf("%s - Failed to render", g.Config.Title), "err", err)
+	}
 }
 
 // Update updates the game state
 func (g *Game) Update(dt float64) error {
-	g.Scenes.Update(dt)
-	return nil
+	return g.Scenes.Update(dt)
 }
 
 // HandleInput processes user input
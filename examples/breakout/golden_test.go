@@ -0,0 +1,41 @@
+package breakout
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kuhree/gg/internal/engine/golden"
+	"github.com/kuhree/gg/internal/engine/scenes"
+)
+
+// goldenSizes are the screens the menus are checked on, a typical terminal and a cramped one
+var goldenSizes = [][2]int{{80, 24}, {50, 16}}
+
+func TestMenusGolden(t *testing.T) {
+	for _, size := range goldenSizes {
+		width, height := size[0], size[1]
+		g, err := NewGame(width, height, t.TempDir(), false, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := g.Init(); err != nil {
+			t.Fatal(err)
+		}
+		g.Score, g.CurrentLevel = 1250, 3
+
+		// The game over screen loads the leaderboard when entered
+		gameOver := g.Scenes.Scene(GameOverSceneID)
+		gameOver.Enter()
+		g.Leaderboard.Add("ada", 4200, g.details())
+		g.Leaderboard.Add("bob", 900, g.details())
+
+		for name, id := range map[string]scenes.SceneID{
+			"main_menu":  MainMenuSceneID,
+			"pause_menu": PauseMenuSceneID,
+			"game_over":  GameOverSceneID,
+		} {
+			frame := golden.Draw(t, width, height, g.Scenes.Scene(id).Draw)
+			golden.Assert(t, fmt.Sprintf("%s_%dx%d", name, width, height), frame, golden.WithColors())
+		}
+	}
+}
@@ -3,25 +3,30 @@ package breakout
 import (
 	"fmt"
 	"math"
+	"slices"
 
 	"github.com/kuhree/gg/internal/engine/core"
-	"github.com/kuhree/gg/internal/engine/leaderboard"
+	"github.com/kuhree/gg/internal/engine/events"
 	"github.com/kuhree/gg/internal/engine/objects"
+	"github.com/kuhree/gg/internal/engine/objects/collision"
+	"github.com/kuhree/gg/internal/engine/objects/physics"
+	"github.com/kuhree/gg/internal/engine/particles"
 	"github.com/kuhree/gg/internal/engine/render"
+	"github.com/kuhree/gg/internal/engine/scenes"
+	"github.com/kuhree/gg/internal/engine/ui"
 )
 
-const (
-	titleOffset = 1.0 / 10
-	lineSpacing = 2
-)
+// wallThickness is thick enough that the ball can't pass a wall in one frame
+const wallThickness = 10
+
+// roleBall is the theme role for the ball, see render.Theme
+const roleBall = "ball"
 
 // BaseScene provides common functionality for all scenes
 type BaseScene struct {
 	*Game
-	sceneName     string
-	blinkTimer    float64
-	blinkInterval float64
-	showOnBlink   bool
+	sceneName string
+	blink     *ui.Blink
 }
 
 // Enter logs when a scene is entered
@@ -34,13 +39,20 @@ func (s *BaseScene) Exit() {
 	s.Logger.Info("Exiting scene", "scene", s.sceneName)
 }
 
+// Pause logs when an overlay is pushed on top of a scene
+func (s *BaseScene) Pause() {
+	s.Logger.Info("Pausing scene", "scene", s.sceneName)
+}
+
+// Resume logs when the overlay on top of a scene is popped
+func (s *BaseScene) Resume() {
+	s.Logger.Info("Resuming scene", "scene", s.sceneName)
+}
+
 // Update is a no-op for scenes that don't need updates
-func (s *BaseScene) Update(dt float64) {
-	s.blinkTimer += dt
-	if s.blinkTimer >= s.blinkInterval {
-		s.blinkTimer = 0
-		s.showOnBlink = !s.showOnBlink
-	}
+func (s *BaseScene) Update(dt float64) error {
+	s.blink.Update(dt)
+	return nil
 }
 
 // HandleInput is a no-op for scenes that don't handle input
@@ -48,11 +60,6 @@ func (s *BaseScene) HandleInput(input core.InputEvent) error {
 	return nil
 }
 
-// MainMenuScene represents the main menu
-type MainMenuScene struct {
-	BaseScene
-}
-
 // PlayingScene represents the main gameplay
 type PlayingScene struct {
 	BaseScene
@@ -61,43 +68,31 @@ type PlayingScene struct {
 	paddle *Paddle
 	ball   *Ball
 	bricks []*Brick
-}
-
-// PauseMenuScene represents the pause menu
-type PauseMenuScene struct {
-	BaseScene
-}
+	world  *physics.World
 
-// GameOverScene represents the game over screen
-type GameOverScene struct {
-	BaseScene
-	name        string
-	nameEntered bool
-}
+	// walls are the bodies around the playfield and wallSize the size they
+	// were built for, so they're rebuilt when it changes
+	walls    []*physics.Body
+	wallSize [2]int
 
-// NewMainMenuScene creates a new main menu scene
-func NewMainMenuScene(game *Game) *MainMenuScene {
-	return &MainMenuScene{
-		BaseScene: BaseScene{
-			Game:          game,
-			sceneName:     "Main Menu",
-			blinkInterval: 0.5,
-			showOnBlink:   true,
-		},
-	}
+	sparks *particles.Emitter
+	debris *particles.Emitter
 }
 
 // NewPlayingScene creates a new playing scene
 func NewPlayingScene(game *Game) *PlayingScene {
 	scene := &PlayingScene{
 		BaseScene: BaseScene{
-			Game:          game,
-			sceneName:     "Playing",
-			blinkInterval: 0.5,
-			showOnBlink:   true,
+			Game:      game,
+			sceneName: "Playing",
+			blink:     ui.NewBlink(game.Config.BlinkInterval),
 		},
-		lives: game.Config.InitialLives,
+		lives:  game.Config.InitialLives,
+		sparks: particles.NewEmitter(particles.Sparks(), 128),
+		debris: particles.NewEmitter(particles.Explosion(), 256),
+		world:  physics.NewWorld(objects.Vector2D{}),
 	}
+	scene.updateWalls()
 
 	// Initialize paddle
 	scene.paddle = &Paddle{
@@ -111,6 +106,9 @@ func NewPlayingScene(game *Game) *PlayingScene {
 		},
 		Speed: game.Config.PaddleSpeed,
 	}
+	scene.paddle.Body = physics.NewBody(&scene.paddle.GameObject, 0)
+	scene.paddle.Body.OnContact = scene.catchBall
+	scene.world.Add(scene.paddle.Body)
 
 	// Initialize ball
 	scene.ball = &Ball{
@@ -122,9 +120,12 @@ func NewPlayingScene(game *Game) *PlayingScene {
 			Width:  game.Config.BallSize,
 			Height: game.Config.BallSize,
 		},
-		Velocity: objects.Vector2D{X: 0, Y: 0},
 		Attached: true,
 	}
+	// The ball joins the world when it's launched
+	scene.ball.Body = physics.NewBody(&scene.ball.GameObject, 1)
+	scene.ball.Body.Round = true
+	scene.ball.Body.Restitution = 1
 
 	// Initialize bricks
 	scene.initializeBricks()
@@ -132,72 +133,13 @@ func NewPlayingScene(game *Game) *PlayingScene {
 	return scene
 }
 
-// NewPauseMenuScene creates a new pause menu scene
-func NewPauseMenuScene(game *Game) *PauseMenuScene {
-	return &PauseMenuScene{
-		BaseScene: BaseScene{
-			Game:          game,
-			sceneName:     "Pause Menu",
-			blinkInterval: 0.5,
-			showOnBlink:   true,
-		},
-	}
-}
-
-// NewGameOverScene creates a new game over scene
-func NewGameOverScene(game *Game) *GameOverScene {
-	return &GameOverScene{
-		BaseScene: BaseScene{
-			Game:          game,
-			sceneName:     "Game Over",
-			blinkInterval: 0.5,
-			showOnBlink:   true,
-		},
-		name:        "",
-		nameEntered: false,
-	}
-}
-
-// MainMenuScene methods
-
-func (s *MainMenuScene) Draw(renderer *render.Renderer) {
-	width, height := s.Size()
-	startX := width / 10
-
-	const (
-		startOffset    = 1.0 / 6
-		controlsOffset = 2.0 / 8
-	)
-
-	_ = renderer.DrawText(fmt.Sprintf("%s - %s", s.Config.Title, s.sceneName), startX, int(float64(height)*titleOffset), render.ColorWhite)
-
-	if s.showOnBlink {
-		_ = renderer.DrawText("Press ENTER to start", startX, int(float64(height)*startOffset), render.ColorBrightMagenta)
+// PlayingScene methods
+func (s *PlayingScene) Update(dt float64) error {
+	if err := s.BaseScene.Update(dt); err != nil {
+		return err
 	}
 
-	controlsY := int(float64(height) * controlsOffset)
-	_ = renderer.DrawText("Controls:", startX, controlsY, render.ColorBlue)
-	_ = renderer.DrawText("ESC to pause", startX, controlsY+3*lineSpacing, render.ColorWhite)
-	_ = renderer.DrawText("Q to pause/quit", startX, controlsY+4*lineSpacing, render.ColorWhite)
-}
-
-func (s *MainMenuScene) HandleInput(input core.InputEvent) error {
-	switch input.Rune {
-	case core.KeyEnter:
-		s.Logger.Info("Starting new game")
-		s.Scenes.ChangeScene(PlayingSceneID)
-		return nil
-	case 'q', 'Q':
-		s.Scenes.ChangeScene(GameOverSceneID)
-		return core.ErrQuitGame
-	}
-
-	return nil
-}
-
-// PlayingScene methods
-func (s *PlayingScene) Update(dt float64) {
-	s.BaseScene.Update(dt)
+	s.updateWalls()
 
 	// Update paddle position
 	if s.paddle.Position.X < 0 {
@@ -208,45 +150,51 @@ func (s *PlayingScene) Update(dt float64) {
 
 	// Update ball position
 	if s.ball.Attached {
-		s.ball.Position.X = s.paddle.Position.X + s.paddle.Width/2
-		s.ball.Position.Y = s.paddle.Position.Y - 1
-	} else {
-		s.ball.Position.X += s.ball.Velocity.X * dt
-		s.ball.Position.Y += s.ball.Velocity.Y * dt
+		s.ball.Position = s.paddle.Position.Add(objects.Vector2D{X: s.paddle.Width / 2, Y: -1})
 	}
 
-	s.updateCollisions(dt)
+	s.world.Step(dt)
+	s.updateBall()
+	s.sparks.Update(dt)
+	s.debris.Update(dt)
+
 	ended, reason := s.checkGameState(dt)
 	if ended {
-		s.endGame(reason)
+		return s.endGame(reason)
 	}
+	return nil
 }
 
 func (s *PlayingScene) Draw(renderer *render.Renderer) {
 	// Draw paddle
+	paddleColor := renderer.ColorOr(render.RolePlayer, render.ColorCyan)
 	for x := int(s.paddle.Position.X); x < int(s.paddle.Position.X+s.paddle.Width); x++ {
 		y := int(s.paddle.Position.Y)
-		_ = renderer.DrawChar('=', x, y, render.ColorCyan)
-		s.drawObjOverlay(x, y, render.ColorCyan)
+		_ = renderer.DrawChar('=', x, y, paddleColor)
+		s.drawObjOverlay(renderer, x, y, paddleColor)
 	}
 
 	// Draw ball
-	_ = renderer.DrawChar('O', int(s.ball.Position.X), int(s.ball.Position.Y), render.ColorWhite)
-	// s.drawObjOverlay(int(s.ball.Position.X), int(s.ball.Position.X), render.ColorWhite)
+	_ = renderer.DrawChar('O', int(s.ball.Position.X), int(s.ball.Position.Y), renderer.ColorAs(roleBall, render.RoleText, render.ColorWhite))
+	// s.drawObjOverlay(renderer, int(s.ball.Position.X), int(s.ball.Position.X), render.ColorWhite)
 
 	// Draw bricks
 	for _, brick := range s.bricks {
+		color := renderer.RampColor(render.RoleObstacle, brick.Color)
 		for x := int(brick.Position.X); x < int(brick.Position.X+brick.Width); x++ {
 			y := int(brick.Position.Y)
-			_ = renderer.DrawChar('#', x, y, brick.Color)
-			s.drawObjOverlay(x, y, brick.Color)
+			_ = renderer.DrawChar('#', x, y, color)
+			s.drawObjOverlay(renderer, x, y, color)
 		}
 	}
 
+	s.debris.Draw(renderer)
+	s.sparks.Draw(renderer)
+
 	// Draw score, level, lives
-	_ = renderer.DrawText(fmt.Sprintf("Score: %d", s.Score), 1, 1, render.ColorWhite)
-	_ = renderer.DrawText(fmt.Sprintf("Level: %d", s.CurrentLevel), 1, 2, render.ColorWhite)
-	_ = renderer.DrawText(fmt.Sprintf("Lives: %d", s.lives), s.Width-10, 1, render.ColorWhite)
+	_ = renderer.DrawText(fmt.Sprintf("Score: %d", s.Score), 1, 1, renderer.Color(render.RoleHUD))
+	_ = renderer.DrawText(fmt.Sprintf("Level: %d", s.CurrentLevel), 1, 2, renderer.Color(render.RoleHUD))
+	_ = renderer.DrawText(fmt.Sprintf("Lives: %d", s.lives), s.Width-10, 1, renderer.Color(render.RoleHUD))
 }
 
 func (s *PlayingScene) HandleInput(input core.InputEvent) error {
@@ -256,9 +204,9 @@ func (s *PlayingScene) HandleInput(input core.InputEvent) error {
 	case '2', core.KeyF2:
 		s.Overlay = !s.Overlay
 	case 'p', 'P':
-		s.Scenes.ChangeScene(PauseMenuSceneID)
+		return s.Scenes.Push(PauseMenuSceneID, scenes.Frozen)
 	case 'q', 'Q':
-		s.Scenes.ChangeScene(GameOverSceneID)
+		return s.endGame("Quit")
 	case 'a', 'A':
 		s.paddle.Position.X -= s.paddle.Speed
 	case 'd', 'D':
@@ -266,7 +214,8 @@ func (s *PlayingScene) HandleInput(input core.InputEvent) error {
 	case ' ': // Spacebar launches the ball
 		if s.ball.Attached {
 			s.ball.Attached = false
-			s.ball.Velocity = objects.Vector2D{X: s.Config.BallVelocityX, Y: s.Config.BallVelocityY}
+			s.ball.Body.Velocity = objects.Vector2D{X: s.Config.BallVelocityX, Y: s.Config.BallVelocityY}
+			s.world.Add(s.ball.Body)
 		}
 	}
 
@@ -275,142 +224,104 @@ func (s *PlayingScene) HandleInput(input core.InputEvent) error {
 
 // PlayingScene helpers
 
-// updateCollisions detects and handles collisions between game objects
-func (s *PlayingScene) updateCollisions(_ float64) {
-	if s.ball.Attached {
+// updateWalls surrounds the playfield with static walls on every side but
+// the bottom, rebuilding them whenever the playfield changes size
+func (s *PlayingScene) updateWalls() {
+	size := [2]int{s.Width, s.Height}
+	if s.walls != nil && size == s.wallSize {
 		return
 	}
+	for _, wall := range s.walls {
+		s.world.Remove(wall)
+	}
+	s.walls, s.wallSize = s.walls[:0], size
 
-	s.handleWallCollisions()
-	s.handlePaddleCollision()
-	s.handleBrickCollisions()
+	width, height := float64(s.Width), float64(s.Height)
+	walls := []objects.GameObject{
+		{Position: objects.Vector2D{X: -wallThickness, Y: -wallThickness}, Width: wallThickness, Height: height + wallThickness}, // Left
+		{Position: objects.Vector2D{X: width, Y: -wallThickness}, Width: wallThickness, Height: height + wallThickness},          // Right
+		{Position: objects.Vector2D{X: 0, Y: -wallThickness}, Width: width, Height: wallThickness},                               // Top
+	}
+	for i := range walls {
+		wall := physics.NewBody(&walls[i], 0)
+		s.world.Add(wall)
+		s.walls = append(s.walls, wall)
+	}
 }
 
-func (s *PlayingScene) handleWallCollisions() {
-	// Side walls
-	if s.ball.Position.X <= 0 || s.ball.Position.X >= float64(s.Width) {
-		s.ball.Velocity.X = -s.ball.Velocity.X
-		s.ensureMinimumVelocity()
+// updateBall keeps a launched ball moving and takes a life when it falls off the bottom
+func (s *PlayingScene) updateBall() {
+	if s.ball.Attached {
+		return
 	}
 
-	// Top wall
-	if s.ball.Position.Y <= 0 {
-		s.ball.Velocity.Y = -s.ball.Velocity.Y
-		s.ensureMinimumVelocity()
-	}
+	s.ensureMinimumVelocity()
 
 	// Bottom (death)
 	if s.ball.Position.Y >= float64(s.Height) {
 		s.lives--
+		events.Emit(s.Events, events.LifeLost{Lives: s.lives})
 		s.resetBall()
 	}
 }
 
-func (s *PlayingScene) handlePaddleCollision() {
-	paddleLeft := s.paddle.Position.X
-	paddleRight := s.paddle.Position.X + s.paddle.Width
-	paddleTop := s.paddle.Position.Y
-	ballBottom := s.ball.Position.Y + s.ball.Height
+// catchBall sends the ball back up after it hits the paddle, tilted further
+// the further from the center it hit
+func (s *PlayingScene) catchBall(_ *physics.Body, _ collision.Contact) {
+	paddle := collision.Box(s.paddle.GameObject)
+	hitOffset := (s.ball.Position.X - paddle.Center().X) / (s.paddle.Width / 2)
 
-	// Check if ball is within paddle bounds
-	if ballBottom >= paddleTop &&
-		s.ball.Position.Y <= paddleTop &&
-		s.ball.Position.X+s.ball.Width >= paddleLeft &&
-		s.ball.Position.X <= paddleRight {
-
-		// Calculate hit position relative to paddle center
-		paddleCenter := paddleLeft + (s.paddle.Width / 2)
-		hitOffset := (s.ball.Position.X - paddleCenter) / (s.paddle.Width / 2)
-
-		// More dynamic angle based on hit position
-		angle := hitOffset * math.Pi / 3 // 60 degree max angle
-		speed := math.Sqrt(math.Pow(s.ball.Velocity.X, 2) + math.Pow(s.ball.Velocity.Y, 2))
-
-		s.ball.Velocity.X = speed * math.Sin(angle)
-		s.ball.Velocity.Y = -speed * math.Cos(angle)
-
-		s.ensureMinimumVelocity()
-
-		// Move ball out of paddle to prevent multiple collisions
-		s.ball.Position.Y = paddleTop - s.ball.Height
-	}
+	angle := hitOffset * math.Pi / 3 // 60 degree max angle
+	s.ball.Body.Velocity = objects.Up.Scale(s.ball.Body.Velocity.Length()).Rotate(angle)
 }
 
-func (s *PlayingScene) handleBrickCollisions() {
-	for i := len(s.bricks) - 1; i >= 0; i-- {
-		brick := s.bricks[i]
-
-		// Calculate brick edges
-		brickLeft := brick.Position.X
-		brickRight := brick.Position.X + brick.Width
-		brickTop := brick.Position.Y
-		brickBottom := brick.Position.Y + brick.Height
-
-		// Check if ball intersects with brick
-		if s.ball.Position.X+s.ball.Width >= brickLeft &&
-			s.ball.Position.X <= brickRight &&
-			s.ball.Position.Y+s.ball.Height >= brickTop &&
-			s.ball.Position.Y <= brickBottom {
-
-			// Determine collision side
-			fromLeft := math.Abs(s.ball.Position.X + s.ball.Width - brickLeft)
-			fromRight := math.Abs(s.ball.Position.X - brickRight)
-			fromTop := math.Abs(s.ball.Position.Y + s.ball.Height - brickTop)
-			fromBottom := math.Abs(s.ball.Position.Y - brickBottom)
-
-			// Find smallest penetration
-			min := math.Min(fromLeft, math.Min(fromRight, math.Min(fromTop, fromBottom)))
-
-			// Reflect based on collision side
-			switch min {
-			case fromLeft, fromRight:
-				s.ball.Velocity.X = -s.ball.Velocity.X
-			case fromTop, fromBottom:
-				s.ball.Velocity.Y = -s.ball.Velocity.Y
-			}
-
-			brick.Health--
-			if brick.Health <= 0 {
-				s.Score += brick.Points
-				s.bricks = append(s.bricks[:i], s.bricks[i+1:]...)
-			}
-
-			s.ensureMinimumVelocity()
-			break
-		}
+// hitBrick damages a brick the ball bounced off, knocking it out once it has no health left
+func (s *PlayingScene) hitBrick(brick *Brick) {
+	brick.Health--
+	s.sparks.Burst(s.ball.Position.X, s.ball.Position.Y, 8)
+	if brick.Health <= 0 {
+		s.debris.Burst(brick.Position.X+brick.Width/2, brick.Position.Y, int(brick.Width)*3)
+		s.SetScore(s.Score + brick.Points)
+		s.world.Remove(brick.Body)
+		s.bricks = slices.DeleteFunc(s.bricks, func(b *Brick) bool { return b == brick })
 	}
 }
 
 func (s *PlayingScene) ensureMinimumVelocity() {
 	// Ensure minimum X velocity
-	if math.Abs(s.ball.Velocity.X) < s.Config.BallMinXVelocity {
-		if s.ball.Velocity.X < 0 {
-			s.ball.Velocity.X = -s.Config.BallMinXVelocity
+	if math.Abs(s.ball.Body.Velocity.X) < s.Config.BallMinXVelocity {
+		if s.ball.Body.Velocity.X < 0 {
+			s.ball.Body.Velocity.X = -s.Config.BallMinXVelocity
 		} else {
-			s.ball.Velocity.X = s.Config.BallMinXVelocity
+			s.ball.Body.Velocity.X = s.Config.BallMinXVelocity
 		}
 	}
 
 	// Ensure minimum Y velocity
-	if math.Abs(s.ball.Velocity.Y) < s.Config.BallMinYVelocity {
-		if s.ball.Velocity.Y < 0 {
-			s.ball.Velocity.Y = -s.Config.BallMinYVelocity
+	if math.Abs(s.ball.Body.Velocity.Y) < s.Config.BallMinYVelocity {
+		if s.ball.Body.Velocity.Y < 0 {
+			s.ball.Body.Velocity.Y = -s.Config.BallMinYVelocity
 		} else {
-			s.ball.Velocity.Y = s.Config.BallMinYVelocity
+			s.ball.Body.Velocity.Y = s.Config.BallMinYVelocity
 		}
 	}
 }
 
 func (s *PlayingScene) resetBall() {
 	s.ball.Attached = true
-	s.ball.Position.X = s.paddle.Position.X + s.paddle.Width/2
-	s.ball.Position.Y = s.paddle.Position.Y - s.Config.BallSize
-	s.ball.Velocity = objects.Vector2D{X: 0, Y: 0}
+	s.ball.Position = s.paddle.Position.Add(objects.Vector2D{X: s.paddle.Width / 2, Y: -s.Config.BallSize})
+	s.ball.Body.Velocity = objects.Vector2D{}
+	s.world.Remove(s.ball.Body)
 }
 
 func (s *PlayingScene) initializeBricks() {
+	for _, brick := range s.bricks {
+		s.world.Remove(brick.Body)
+	}
 	s.bricks = make([]*Brick, 0)
 
+	// The rows are a rainbow rather than roles, so themes leave them alone
+	// apart from semantic themes, which draw them all as obstacles
 	brickColors := []render.Color{
 		render.ColorMagenta,
 		render.ColorRed,
@@ -440,6 +351,9 @@ func (s *PlayingScene) initializeBricks() {
 				Points: (rows - row) * 10,
 				Color:  brickColors[row%len(brickColors)],
 			}
+			brick.Body = physics.NewBody(&brick.GameObject, 0)
+			brick.Body.OnContact = func(*physics.Body, collision.Contact) { s.hitBrick(brick) }
+			s.world.Add(brick.Body)
 			s.bricks = append(s.bricks, brick)
 		}
 	}
@@ -452,7 +366,7 @@ func (s *PlayingScene) checkGameState(_ float64) (bool, string) {
 	}
 
 	if len(s.bricks) == 0 {
-		s.CurrentLevel++
+		s.SetLevel(s.CurrentLevel + 1)
 		s.initializeBricks()
 		s.resetBall()
 		return false, ""
@@ -461,19 +375,19 @@ func (s *PlayingScene) checkGameState(_ float64) (bool, string) {
 	return false, ""
 }
 
-func (s *PlayingScene) endGame(reason string) {
-	s.Logger.Info("Game over", "reason", reason, "score", s.Score, "level", s.CurrentLevel+1)
-	s.Scenes.ChangeScene(GameOverSceneID)
+func (s *PlayingScene) endGame(reason string) error {
+	s.GameOver(reason)
+	return s.Scenes.ChangeSceneWith(GameOverSceneID, gameOverTransition)
 }
 
-func (s *PlayingScene) drawObjOverlay(x, y int, color render.Color) {
+func (s *PlayingScene) drawObjOverlay(renderer *render.Renderer, x, y int, color render.Color) {
 	if !s.Overlay && !s.Debug {
 		return
 	}
 
 	if s.Overlay {
 		char := '0'
-		_ = s.Renderer.DrawChar(char, x, y, color)
+		_ = renderer.DrawChar(char, x, y, color)
 	}
 
 	if s.Debug {
@@ -483,152 +397,7 @@ func (s *PlayingScene) drawObjOverlay(x, y int, color render.Color) {
 		}
 
 		for i, info := range debugInfo {
-			_ = s.Renderer.DrawText(info, x+1, y+i, color)
+			_ = renderer.DrawText(info, x+1, y+i, color)
 		}
 	}
 }
-
-// PauseMenuScene methods
-
-func (s *PauseMenuScene) Draw(renderer *render.Renderer) {
-	const (
-		scoreOffset    = 1.0 / 6
-		controlsOffset = 1.0 / 4
-	)
-
-	width, height := s.Size()
-	startX := width / 10
-
-	// Draw title
-	_ = renderer.DrawText(fmt.Sprintf("%s - %s", s.Config.Title, s.sceneName), startX, int(float64(height)*titleOffset), render.ColorWhite)
-
-	if s.showOnBlink {
-		_ = renderer.DrawText(
-			fmt.Sprintf("Score: %d | Level: %d", s.Score, s.CurrentLevel),
-			startX,
-			int(float64(height)*scoreOffset),
-			render.ColorMagenta,
-		)
-	}
-
-	// Draw controls
-	controlsY := int(float64(height) * controlsOffset)
-	_ = renderer.DrawText("Controls:", startX, controlsY, render.ColorBlue)
-	_ = renderer.DrawText("Press ESC to resume", startX, controlsY+lineSpacing, render.ColorWhite)
-	_ = renderer.DrawText("Press Q to quit", startX, controlsY+2*lineSpacing, render.ColorWhite)
-}
-
-func (s *PauseMenuScene) HandleInput(input core.InputEvent) error {
-	switch input.Rune {
-	case core.KeyEscape:
-		s.Scenes.ChangeScene(PlayingSceneID)
-	case 'q', 'Q':
-		s.Scenes.ChangeScene(GameOverSceneID)
-		return core.ErrQuitGame
-	}
-
-	return nil
-}
-
-// GameOverScene methods
-
-func (s *GameOverScene) Enter() {
-	s.BaseScene.Enter()
-	err := s.Leaderboard.Load(s.Config.BoardFile)
-	if err != nil {
-		s.Logger.Warn("Failed to load existing leaderboard. Creating a new one...", "path", s.Config.BoardFile, "err", err)
-		s.Leaderboard.Records = make([]leaderboard.Record, 0)
-	}
-}
-
-func (s *GameOverScene) GetDetails() string {
-	width, height := s.Size()
-	return fmt.Sprintf(
-		"%dW*%dH|L%d",
-		width, height,
-		s.CurrentLevel,
-	)
-}
-
-func (s *GameOverScene) Draw(renderer *render.Renderer) {
-	const (
-		scoreOffset       = 1.0 / 6
-		leaderboardOffset = 1.0 / 4
-		controlsOffset    = 3.0 / 4
-	)
-
-	width, height := s.Size()
-	startX := width / 10
-
-	// Draw title and game over message
-	_ = renderer.DrawText(fmt.Sprintf("%s - %s", s.Config.Title, s.sceneName), startX, int(float64(height)*titleOffset), render.ColorWhite)
-
-	if s.Score > 0 && !s.nameEntered {
-		// Draw name entry prompt and score
-		_ = renderer.DrawText(fmt.Sprintf("Score: %d", s.Score), startX, int(float64(height)*scoreOffset), render.ColorWhite)
-		_ = renderer.DrawText("Enter your name to save score (or press Q to skip):", startX, int(float64(height)*scoreOffset)+1, render.ColorWhite)
-		if s.showOnBlink {
-			_ = renderer.DrawText(s.name+"_", startX, int(float64(height)*scoreOffset)+2, render.ColorBrightMagenta)
-		} else {
-			_ = renderer.DrawText(s.name, startX, int(float64(height)*scoreOffset)+2, render.ColorBrightMagenta)
-		}
-	} else if s.Score > 0 && s.showOnBlink {
-		_ = renderer.DrawText(
-			fmt.Sprintf("%d | %s > %s", s.Score, s.name, s.GetDetails()),
-			startX,
-			int(float64(height)*scoreOffset),
-			render.ColorMagenta,
-		)
-	}
-
-	// Draw leaderboard
-	leaderboardY := int(float64(height) * leaderboardOffset)
-	_ = renderer.DrawText("Top Scores:", startX, leaderboardY, render.ColorBlue)
-	topScores := s.Leaderboard.TopScores(s.Config.LeaderboardSize)
-	for i, entry := range topScores {
-		_ = renderer.DrawText(fmt.Sprintf("%d | %s > %s", entry.Score, entry.Name, entry.Details), startX, leaderboardY+(i+1)*lineSpacing, render.ColorWhite)
-	}
-
-	// Draw controls
-	controlsY := int(float64(height) * controlsOffset)
-	_ = renderer.DrawText("Controls:", startX, controlsY, render.ColorBlue)
-	_ = renderer.DrawText("Press Q to quit the game", startX, controlsY+lineSpacing, render.ColorWhite)
-	_ = renderer.DrawText("Press ENTER to return to save/return to main menu", startX, controlsY+2*lineSpacing, render.ColorWhite)
-}
-
-func (s *GameOverScene) HandleInput(input core.InputEvent) error {
-	switch input.Rune {
-	case 'q', 'Q':
-		if !s.nameEntered && s.Score > 0 {
-			s.Logger.Info("Skipping leaderboard entry")
-			s.nameEntered = true
-		}
-		return core.ErrQuitGame
-	case core.KeyEnter:
-		if !s.nameEntered {
-			if len(s.name) > 0 && s.Score > 0 {
-				s.nameEntered = true
-				s.Logger.Info("Adding leaderboard entry...", "name", s.name, "score", s.Score)
-				s.Leaderboard.Add(s.name, s.Score, s.GetDetails())
-				err := s.Leaderboard.Save(s.Config.BoardFile)
-				if err != nil {
-					return err
-				}
-			}
-		} else {
-			s.Scenes.ChangeScene(MainMenuSceneID)
-		}
-	case core.KeyBackspace:
-		if !s.nameEntered && len(s.name) > 0 {
-			s.name = s.name[:len(s.name)-1]
-		}
-	default:
-		if !s.nameEntered {
-			// Only allow printable characters
-			if input.Rune >= 32 && input.Rune <= 126 && len(s.name) < s.Config.MaxNameLength {
-				s.name += string(input.Rune)
-			}
-		}
-	}
-	return nil
-}
@@ -0,0 +1,33 @@
+package breakout
+
+import "testing"
+
+func TestWallsFollowThePlayfield(t *testing.T) {
+	g, err := NewGame(80, 24, t.TempDir(), false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewPlayingScene(g)
+
+	right := func() float64 {
+		t.Helper()
+		if len(s.walls) != 3 {
+			t.Fatalf("%d walls, want 3", len(s.walls))
+		}
+		return s.walls[1].Object.Position.X
+	}
+	if got := right(); got != 80 {
+		t.Errorf("right wall at %v, want 80", got)
+	}
+
+	g.Width, g.Height = 100, 30
+	if err := s.Update(0.01); err != nil {
+		t.Fatal(err)
+	}
+	if got := right(); got != 100 {
+		t.Errorf("right wall at %v after resizing, want 100", got)
+	}
+	if n := len(s.world.Bodies()); n != 3+1+len(s.bricks) {
+		t.Errorf("%d bodies in the world, want the old walls removed", n)
+	}
+}
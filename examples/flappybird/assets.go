@@ -0,0 +1,15 @@
+package flappybird
+
+import (
+	"embed"
+
+	"github.com/kuhree/gg/internal/engine/render"
+)
+
+//go:embed assets/*.sprite
+var assets embed.FS
+
+// loadSprites loads every sprite bundled with the game
+func loadSprites() (map[string]*render.Sprite, error) {
+	return render.LoadSprites(assets, "assets/*.sprite")
+}
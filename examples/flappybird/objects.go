@@ -2,43 +2,40 @@ package flappybird
 
 import (
 	"github.com/kuhree/gg/internal/engine/objects"
+	"github.com/kuhree/gg/internal/engine/objects/physics"
 	"github.com/kuhree/gg/internal/engine/render"
 )
 
 // Bird represents the player-controlled bird
 type Bird struct {
 	objects.GameObject
-	Velocity  float64
-	Gravity   float64
+	Body      *physics.Body
 	JumpForce float64
-	Character rune
-	Color     render.Color
+	Animation *render.Animation
 	IsDead    bool
 }
 
 // Pipe represents an obstacle pipe
 type Pipe struct {
 	objects.GameObject
-	Color       render.Color
 	IsUpperPipe bool
 	Scored      bool
 }
 
-// NewBird creates a new bird instance
-func NewBird(x, y float64, config *Config) *Bird {
-	return &Bird{
+// NewBird creates a new bird instance, flapping with the given sprite
+func NewBird(x, y float64, config *Config, sprite *render.Sprite) *Bird {
+	bird := &Bird{
 		GameObject: objects.GameObject{
 			Position: objects.Vector2D{X: x, Y: y},
 			Width:    1,
 			Height:   1,
 		},
-		Velocity:  0,
-		Gravity:   config.BirdGravity,
 		JumpForce: config.BirdJumpForce,
-		Character: '>',
-		Color:     render.ColorYellow,
+		Animation: render.NewAnimation(sprite, true),
 		IsDead:    false,
 	}
+	bird.Body = physics.NewBody(&bird.GameObject, 1)
+	return bird
 }
 
 // NewPipe creates a new pipe instance
@@ -49,7 +46,6 @@ func NewPipe(x, y float64, height float64, width float64, isUpper bool) *Pipe {
 			Width:    width,
 			Height:   height,
 		},
-		Color:       render.ColorGreen,
 		IsUpperPipe: isUpper,
 		Scored:      false,
 	}
@@ -5,15 +5,19 @@ import (
 	"math/rand/v2"
 
 	"github.com/kuhree/gg/internal/engine/core"
-	"github.com/kuhree/gg/internal/engine/leaderboard"
+	"github.com/kuhree/gg/internal/engine/events"
+	"github.com/kuhree/gg/internal/engine/objects"
+	"github.com/kuhree/gg/internal/engine/objects/collision"
+	"github.com/kuhree/gg/internal/engine/objects/physics"
+	"github.com/kuhree/gg/internal/engine/particles"
 	"github.com/kuhree/gg/internal/engine/render"
+	"github.com/kuhree/gg/internal/engine/scenes"
+	"github.com/kuhree/gg/internal/engine/timing"
+	"github.com/kuhree/gg/internal/engine/ui"
 )
 
+// Difficulty bounds
 const (
-	titleOffset = 1.0 / 10
-	lineSpacing = 2
-
-	// Difficulty bounds
 	minPipeSpeed   = 10.0 // Minimum pipe speed
 	maxPipeSpeed   = 50.0 // Maximum pipe speed
 	minPipeGap     = 5.0  // Minimum gap between pipes
@@ -27,10 +31,8 @@ const (
 // BaseScene provides common functionality for all scenes
 type BaseScene struct {
 	*Game
-	sceneName     string
-	blinkTimer    float64
-	blinkInterval float64
-	showOnBlink   bool
+	sceneName string
+	blink     *ui.Blink
 }
 
 // Enter logs when a scene is entered
@@ -43,13 +45,20 @@ func (s *BaseScene) Exit() {
 	s.Logger.Info("Exiting scene", "scene", s.sceneName)
 }
 
+// Pause logs when an overlay is pushed on top of a scene
+func (s *BaseScene) Pause() {
+	s.Logger.Info("Pausing scene", "scene", s.sceneName)
+}
+
+// Resume logs when the overlay on top of a scene is popped
+func (s *BaseScene) Resume() {
+	s.Logger.Info("Resuming scene", "scene", s.sceneName)
+}
+
 // Update is a no-op for scenes that don't need updates
-func (s *BaseScene) Update(dt float64) {
-	s.blinkTimer += dt
-	if s.blinkTimer >= s.blinkInterval {
-		s.blinkTimer = 0
-		s.showOnBlink = !s.showOnBlink
-	}
+func (s *BaseScene) Update(dt float64) error {
+	s.blink.Update(dt)
+	return nil
 }
 
 // HandleInput is a no-op for scenes that don't handle input
@@ -57,11 +66,6 @@ func (s *BaseScene) HandleInput(input core.InputEvent) error {
 	return nil
 }
 
-// MainMenuScene represents the main menu
-type MainMenuScene struct {
-	BaseScene
-}
-
 // PlayingScene represents the main gameplay
 type PlayingScene struct {
 	BaseScene
@@ -69,10 +73,14 @@ type PlayingScene struct {
 	lives int
 	bird  *Bird
 	pipes []*Pipe
+	world *physics.World
 
-	pipeTimer   float64
+	pipeTimer   *timing.Timer
 	gameStarted bool
 
+	explosions *particles.Emitter
+	smoke      *particles.Emitter
+
 	// Current difficulty settings
 	currentPipeSpeed   float64
 	currentPipeGap     float64
@@ -80,135 +88,57 @@ type PlayingScene struct {
 	currentPipeSpacing float64
 }
 
-// PauseMenuScene represents the pause menu
-type PauseMenuScene struct {
-	BaseScene
-}
-
-// GameOverScene represents the game over screen
-type GameOverScene struct {
-	BaseScene
-	name        string
-	nameEntered bool
-}
-
-// NewMainMenuScene creates a new main menu scene
-func NewMainMenuScene(game *Game) *MainMenuScene {
-	return &MainMenuScene{
-		BaseScene: BaseScene{
-			Game:          game,
-			sceneName:     "Main Menu",
-			blinkInterval: 0.5,
-			showOnBlink:   true,
-		},
-	}
-}
-
 // NewPlayingScene creates a new playing scene
 func NewPlayingScene(game *Game) *PlayingScene {
 	scene := &PlayingScene{
 		BaseScene: BaseScene{
-			Game:          game,
-			sceneName:     "Playing",
-			blinkInterval: 0.5,
-			showOnBlink:   true,
+			Game:      game,
+			sceneName: "Playing",
+			blink:     ui.NewBlink(game.Config.BlinkInterval),
 		},
 		lives:              game.Config.InitialLives,
+		pipeTimer:          timing.Every(game.Config.PipeSpacing/game.Config.PipeSpeed, nil),
 		pipes:              make([]*Pipe, 0),
+		world:              physics.NewWorld(objects.Vector2D{Y: game.Config.BirdGravity}),
 		currentPipeSpeed:   game.Config.PipeSpeed,
 		currentPipeGap:     game.Config.PipeGap,
 		currentGravity:     game.Config.BirdGravity,
 		currentPipeSpacing: game.Config.PipeSpacing,
+		explosions:         particles.NewEmitter(particles.Explosion(), 128),
+		smoke:              particles.NewEmitter(particles.Smoke(), 128),
 	}
 
 	return scene
 }
 
-// NewPauseMenuScene creates a new pause menu scene
-func NewPauseMenuScene(game *Game) *PauseMenuScene {
-	return &PauseMenuScene{
-		BaseScene: BaseScene{
-			Game:          game,
-			sceneName:     "Pause Menu",
-			blinkInterval: 0.5,
-			showOnBlink:   true,
-		},
-	}
-}
-
-// NewGameOverScene creates a new game over scene
-func NewGameOverScene(game *Game) *GameOverScene {
-	return &GameOverScene{
-		BaseScene: BaseScene{
-			Game:          game,
-			sceneName:     "Game Over",
-			blinkInterval: 0.5,
-			showOnBlink:   true,
-		},
-		name:        "",
-		nameEntered: false,
-	}
-}
-
-// MainMenuScene methods
-
-func (s *MainMenuScene) Draw(renderer *render.Renderer) {
-	width, height := s.Size()
-	startX := width / 10
-
-	const (
-		startOffset    = 1.0 / 6
-		controlsOffset = 2.0 / 8
-	)
-
-	_ = renderer.DrawText(fmt.Sprintf("%s - %s", s.Config.Title, s.sceneName), startX, int(float64(height)*titleOffset), render.ColorWhite)
-
-	if s.showOnBlink {
-		_ = renderer.DrawText("Press ENTER to start", startX, int(float64(height)*startOffset), render.ColorBrightMagenta)
-	}
-
-	controlsY := int(float64(height) * controlsOffset)
-	_ = renderer.DrawText("Controls:", startX, controlsY, render.ColorBlue)
-	_ = renderer.DrawText("ESC to pause", startX, controlsY+3*lineSpacing, render.ColorWhite)
-	_ = renderer.DrawText("Q to pause/quit", startX, controlsY+4*lineSpacing, render.ColorWhite)
-}
-
-func (s *MainMenuScene) HandleInput(input core.InputEvent) error {
-	switch input.Rune {
-	case core.KeyEnter:
-		s.Logger.Info("Starting new game")
-		s.Scenes.ChangeScene(PlayingSceneID)
-		return nil
-	case 'q', 'Q':
-		s.Scenes.ChangeScene(GameOverSceneID)
-		return core.ErrQuitGame
-	}
-
-	return nil
-}
-
 // PlayingScene methods
 
-func (s *PlayingScene) Update(dt float64) {
-	s.BaseScene.Update(dt)
+func (s *PlayingScene) Update(dt float64) error {
+	if err := s.BaseScene.Update(dt); err != nil {
+		return err
+	}
+	s.explosions.Update(dt)
+	s.smoke.Update(dt)
 
 	if !s.gameStarted {
 		// Initialize bird in center when game starts
 		if s.bird == nil {
-			s.bird = NewBird(float64(s.Width)/3, float64(s.Height)/2, s.Config)
-			s.bird.Gravity = s.currentGravity
+			s.bird = NewBird(float64(s.Width)/3, float64(s.Height)/2, s.Config, s.Sprites["bird"])
+			s.world.Clear()
+			s.world.Add(s.bird.Body)
+			// Gravity is fixed for the bird's life, level changes apply to the next one
+			s.world.Gravity.Y = s.currentGravity
 		}
-		return
+		return nil
 	}
 
 	// Update bird physics
-	s.bird.Velocity += s.bird.Gravity * dt
-	s.bird.Position.Y += s.bird.Velocity * dt
+	s.bird.Animation.Update(dt)
+	s.world.Step(dt)
 
 	// Update pipes
-	s.pipeTimer += dt
-	if s.pipeTimer >= s.currentPipeSpacing/s.currentPipeSpeed {
-		s.pipeTimer = 0
+	s.pipeTimer.Duration = s.currentPipeSpacing / s.currentPipeSpeed
+	if s.pipeTimer.Update(dt) {
 		s.spawnPipes()
 	}
 
@@ -228,8 +158,9 @@ func (s *PlayingScene) Update(dt float64) {
 	s.updateCollisions(dt)
 	ended, reason := s.checkGameState(dt)
 	if ended {
-		s.endGame(reason)
+		return s.endGame(reason)
 	}
+	return nil
 }
 
 func (s *PlayingScene) spawnPipes() {
@@ -253,53 +184,54 @@ func (s *PlayingScene) spawnPipes() {
 
 func (s *PlayingScene) Draw(renderer *render.Renderer) {
 	// Draw score, lives and debug info
-	_ = renderer.DrawText(fmt.Sprintf("Score: %d", s.Score), 1, 1, render.ColorWhite)
-	_ = renderer.DrawText(fmt.Sprintf("Lives: %d", s.lives), 1, 2, render.ColorWhite)
-	_ = renderer.DrawText(fmt.Sprintf("Level: %d", s.CurrentLevel), 1, 3, render.ColorWhite)
+	_ = renderer.DrawText(fmt.Sprintf("Score: %d", s.Score), 1, 1, renderer.Color(render.RoleHUD))
+	_ = renderer.DrawText(fmt.Sprintf("Lives: %d", s.lives), 1, 2, renderer.Color(render.RoleHUD))
+	_ = renderer.DrawText(fmt.Sprintf("Level: %d", s.CurrentLevel), 1, 3, renderer.Color(render.RoleHUD))
 	if s.Debug {
-		_ = renderer.DrawText("Difficulty:", 1, 5, render.ColorBrightBlue)
-		_ = renderer.DrawText(fmt.Sprintf("Speed: %.1f", s.currentPipeSpeed), 1, 6, render.ColorWhite)
-		_ = renderer.DrawText(fmt.Sprintf("Gap: %.1f", s.currentPipeGap), 1, 7, render.ColorWhite)
-		_ = renderer.DrawText(fmt.Sprintf("Spacing: %.1f", s.currentPipeSpacing), 1, 8, render.ColorWhite)
-		_ = renderer.DrawText(fmt.Sprintf("Gravity: %.1f", s.currentGravity), 1, 9, render.ColorWhite)
+		_ = renderer.DrawText("Difficulty:", 1, 5, renderer.Color(render.RoleDebug))
+		_ = renderer.DrawText(fmt.Sprintf("Speed: %.1f", s.currentPipeSpeed), 1, 6, renderer.Color(render.RoleHUD))
+		_ = renderer.DrawText(fmt.Sprintf("Gap: %.1f", s.currentPipeGap), 1, 7, renderer.Color(render.RoleHUD))
+		_ = renderer.DrawText(fmt.Sprintf("Spacing: %.1f", s.currentPipeSpacing), 1, 8, renderer.Color(render.RoleHUD))
+		_ = renderer.DrawText(fmt.Sprintf("Gravity: %.1f", s.currentGravity), 1, 9, renderer.Color(render.RoleHUD))
 	}
 
 	// Draw start message
 	if !s.gameStarted {
-		msg := "Press SPACE to start!"
-		x := (s.Width - len(msg)) / 2
-		y := s.Height / 2
-		_ = renderer.DrawText(msg, x, y, render.ColorBrightMagenta)
+		_ = renderer.DrawTextAligned("Press SPACE to start!", 0, s.Height/2, s.Width, render.AlignCenter, renderer.Color(render.RoleAccent))
 	}
 
 	// Draw bird
 	if s.bird != nil {
-		_ = renderer.DrawChar(s.bird.Character, int(s.bird.Position.X), int(s.bird.Position.Y), s.bird.Color)
-		s.drawObjOverlay(int(s.bird.Position.X), int(s.bird.Position.Y), render.ColorWhite)
+		_ = s.bird.Animation.DrawColor(renderer, int(s.bird.Position.X), int(s.bird.Position.Y), renderer.ColorOr(render.RolePlayer, render.ColorYellow))
+		s.drawObjOverlay(renderer, int(s.bird.Position.X), int(s.bird.Position.Y), renderer.Color(render.RoleOverlay))
 	}
 
 	// Draw pipes
+	pipeColor := renderer.Color(render.RoleObstacle)
 	for _, pipe := range s.pipes {
 		pipeX := int(pipe.Position.X)
 		if pipe.IsUpperPipe {
 			for y := 0; y < int(pipe.Height); y++ {
-				_ = renderer.DrawChar('|', pipeX, y, pipe.Color)
+				_ = renderer.DrawChar('|', pipeX, y, pipeColor)
 				for i := 0; i < int(pipe.Width); i++ {
-					_ = renderer.DrawChar('|', pipeX+i, y, pipe.Color)
+					_ = renderer.DrawChar('|', pipeX+i, y, pipeColor)
 				}
 			}
 		} else {
 			startY := int(pipe.Position.Y)
 			for y := startY; y < startY+int(pipe.Height); y++ {
-				_ = renderer.DrawChar('|', pipeX, y, pipe.Color)
+				_ = renderer.DrawChar('|', pipeX, y, pipeColor)
 				for i := 0; i < int(pipe.Width); i++ {
-					_ = renderer.DrawChar('|', pipeX+i, y, pipe.Color)
+					_ = renderer.DrawChar('|', pipeX+i, y, pipeColor)
 				}
 			}
 		}
 
-		s.drawObjOverlay(int(pipeX), int(pipe.Position.Y), render.ColorWhite)
+		s.drawObjOverlay(renderer, int(pipeX), int(pipe.Position.Y), renderer.Color(render.RoleOverlay))
 	}
+
+	s.smoke.Draw(renderer)
+	s.explosions.Draw(renderer)
 }
 
 func (s *PlayingScene) HandleInput(input core.InputEvent) error {
@@ -309,9 +241,9 @@ func (s *PlayingScene) HandleInput(input core.InputEvent) error {
 	case '2', core.KeyF2:
 		s.Overlay = !s.Overlay
 	case 'p', 'P':
-		s.Scenes.ChangeScene(PauseMenuSceneID)
+		return s.Scenes.Push(PauseMenuSceneID, scenes.Frozen)
 	case 'q', 'Q':
-		s.Scenes.ChangeScene(GameOverSceneID)
+		return s.endGame("Quit")
 	case '=', '+':
 		s.increaseLevel()
 	case '-', '_':
@@ -321,7 +253,8 @@ func (s *PlayingScene) HandleInput(input core.InputEvent) error {
 			s.gameStarted = true
 		}
 		if s.bird != nil && !s.bird.IsDead {
-			s.bird.Velocity = s.bird.JumpForce
+			s.bird.Body.Velocity.Y = s.bird.JumpForce
+			s.bird.Animation.Reset()
 		}
 	}
 
@@ -343,30 +276,20 @@ func (s *PlayingScene) updateCollisions(_ float64) {
 	}
 
 	// Check pipe collisions
+	bird := collision.Box(s.bird.GameObject)
 	birdX := int(s.bird.Position.X)
-	birdY := int(s.bird.Position.Y)
 
 	for _, pipe := range s.pipes {
 		pipeX := int(pipe.Position.X)
 
-		// Only check pipes the bird is passing through
-		if birdX >= pipeX && birdX <= pipeX+int(pipe.Width) {
-			if pipe.IsUpperPipe {
-				if birdY < int(pipe.Height) {
-					s.bird.IsDead = true
-					return
-				}
-			} else {
-				if birdY >= int(pipe.Position.Y) {
-					s.bird.IsDead = true
-					return
-				}
-			}
+		if collision.Overlaps(bird, collision.Box(pipe.GameObject)) {
+			s.bird.IsDead = true
+			return
 		}
 
 		// Score point when passing pipe (only count lower pipe to avoid double scoring)
 		if birdX == pipeX+int(pipe.Width) && !pipe.IsUpperPipe && !pipe.Scored {
-			s.Score++
+			s.SetScore(s.Score + 1)
 			pipe.Scored = true
 
 			// Increase difficulty every 5 points
@@ -380,7 +303,10 @@ func (s *PlayingScene) updateCollisions(_ float64) {
 // checkGameState determines if the game should end
 func (s *PlayingScene) checkGameState(_ float64) (bool, string) {
 	if s.bird != nil && s.bird.IsDead {
+		s.explosions.Burst(s.bird.Position.X, s.bird.Position.Y, 40)
+		s.smoke.Burst(s.bird.Position.X, s.bird.Position.Y, 15)
 		s.lives--
+		events.Emit(s.Events, events.LifeLost{Lives: s.lives})
 		if s.lives <= 0 {
 			return true, "Out of lives"
 		}
@@ -396,7 +322,7 @@ func (s *PlayingScene) checkGameState(_ float64) (bool, string) {
 
 // increaseLevel increases the game difficulty by adjusting various parameters
 func (s *PlayingScene) increaseLevel() {
-	s.CurrentLevel++
+	s.SetLevel(s.CurrentLevel + 1)
 	s.currentPipeSpeed = min(maxPipeSpeed, s.currentPipeSpeed*1.2)       // Increase speed by 20%
 	s.currentPipeGap = max(minPipeGap, s.currentPipeGap*0.9)             // Decrease gap by 10%
 	s.currentGravity = min(maxGravity, s.currentGravity*1.1)             // Increase gravity by 10%
@@ -406,7 +332,7 @@ func (s *PlayingScene) increaseLevel() {
 // decreaseLevel decreases the game difficulty if above level 0
 func (s *PlayingScene) decreaseLevel() {
 	if s.CurrentLevel > 0 {
-		s.CurrentLevel--
+		s.SetLevel(s.CurrentLevel - 1)
 		s.currentPipeSpeed = max(minPipeSpeed, s.currentPipeSpeed/1.2)       // Decrease speed by 20%
 		s.currentPipeGap = min(maxPipeGap, s.currentPipeGap/0.9)             // Increase gap by 10%
 		s.currentGravity = max(minGravity, s.currentGravity/1.1)             // Decrease gravity by 10%
@@ -414,18 +340,18 @@ func (s *PlayingScene) decreaseLevel() {
 	}
 }
 
-func (s *PlayingScene) endGame(reason string) {
-	s.Logger.Info("Game over", "reason", reason, "score", s.Score, "level", s.CurrentLevel+1)
-	s.Scenes.ChangeScene(GameOverSceneID)
+func (s *PlayingScene) endGame(reason string) error {
+	s.GameOver(reason)
+	return s.Scenes.ChangeSceneWith(GameOverSceneID, gameOverTransition)
 }
 
-func (s *PlayingScene) drawObjOverlay(x, y int, color render.Color) {
+func (s *PlayingScene) drawObjOverlay(renderer *render.Renderer, x, y int, color render.Color) {
 	if !s.Overlay && !s.Debug {
 		return
 	}
 
 	if s.Overlay {
-		_ = s.Renderer.DrawChar(render.FullBlock, x, y, color)
+		_ = renderer.DrawChar(render.FullBlock, x, y, color)
 	}
 
 	if s.Debug {
@@ -440,8 +366,8 @@ func (s *PlayingScene) drawObjOverlay(x, y int, color render.Color) {
 		if s.bird != nil && x == int(s.bird.Position.X) && y == int(s.bird.Position.Y) {
 			debugInfo = append(debugInfo,
 				("┌─ Bird Stats ─────────┐"),
-				fmt.Sprintf("│ Velocity: %-10.1f│", s.bird.Velocity),
-				fmt.Sprintf("│ Gravity:  %-10.1f│", s.bird.Gravity),
+				fmt.Sprintf("│ Velocity: %-10.1f│", s.bird.Body.Velocity.Y),
+				fmt.Sprintf("│ Gravity:  %-10.1f│", s.world.Gravity.Y),
 				fmt.Sprintf("│ Jump:     %-10.1f│", s.bird.JumpForce),
 				("└────────────────────┘"),
 			)
@@ -467,159 +393,7 @@ func (s *PlayingScene) drawObjOverlay(x, y int, color render.Color) {
 
 		// Draw debug info offset to the right
 		for i, info := range debugInfo {
-			_ = s.Renderer.DrawText(info, x+3, y+i-len(debugInfo)/2, render.ColorBrightBlue)
+			_ = renderer.DrawText(info, x+3, y+i-len(debugInfo)/2, renderer.Color(render.RoleDebug))
 		}
 	}
 }
-
-// PauseMenuScene methods
-
-func (s *PauseMenuScene) Draw(renderer *render.Renderer) {
-	const (
-		scoreOffset    = 1.0 / 6
-		controlsOffset = 1.0 / 4
-	)
-
-	width, height := s.Size()
-	startX := width / 10
-
-	// Draw title
-	_ = renderer.DrawText(fmt.Sprintf("%s - %s", s.Config.Title, s.sceneName), startX, int(float64(height)*titleOffset), render.ColorWhite)
-
-	if s.showOnBlink {
-		_ = renderer.DrawText(
-			fmt.Sprintf("Score: %d | Level: %d", s.Score, s.CurrentLevel),
-			startX,
-			int(float64(height)*scoreOffset),
-			render.ColorMagenta,
-		)
-	}
-
-	// Draw controls
-	controlsY := int(float64(height) * controlsOffset)
-	_ = renderer.DrawText("Controls:", startX, controlsY, render.ColorBlue)
-	_ = renderer.DrawText("Press ESC to resume", startX, controlsY+lineSpacing, render.ColorWhite)
-	_ = renderer.DrawText("Press Q to quit", startX, controlsY+2*lineSpacing, render.ColorWhite)
-}
-
-func (s *PauseMenuScene) HandleInput(input core.InputEvent) error {
-	switch input.Rune {
-	case core.KeyEscape:
-		s.Scenes.ChangeScene(PlayingSceneID)
-	case 'q', 'Q':
-		s.Scenes.ChangeScene(GameOverSceneID)
-		return core.ErrQuitGame
-	}
-
-	return nil
-}
-
-// GameOverScene methods
-
-func (s *GameOverScene) Enter() {
-	s.BaseScene.Enter()
-	err := s.Leaderboard.Load(s.Config.BoardFile)
-	if err != nil {
-		s.Logger.Warn("Failed to load existing leaderboard. Creating a new one...", "path", s.Config.BoardFile, "err", err)
-		s.Leaderboard.Records = make([]leaderboard.Record, 0)
-	}
-}
-
-func (s *GameOverScene) GetDetails() string {
-	width, height := s.Size()
-	return fmt.Sprintf(
-		"%dW*%dH|L%d|S%d||PS%.1f|PW%.1f|PG%.1f|IL%d|GV%.1f|JF%.1f",
-		width, height,
-		s.CurrentLevel,
-		s.Score,
-		s.Config.PipeSpeed,
-		s.Config.PipeWidth,
-		s.Config.PipeGap,
-		s.Config.InitialLives,
-		s.Config.BirdGravity,
-		s.Config.BirdJumpForce,
-	)
-}
-
-func (s *GameOverScene) Draw(renderer *render.Renderer) {
-	const (
-		scoreOffset       = 1.0 / 6
-		leaderboardOffset = 1.0 / 4
-		controlsOffset    = 3.0 / 4
-	)
-
-	width, height := s.Size()
-	startX := width / 10
-
-	// Draw title and game over message
-	_ = renderer.DrawText(fmt.Sprintf("%s - %s", s.Config.Title, s.sceneName), startX, int(float64(height)*titleOffset), render.ColorWhite)
-
-	if s.Score > 0 && !s.nameEntered {
-		// Draw name entry prompt and score
-		_ = renderer.DrawText(fmt.Sprintf("Score: %d", s.Score), startX, int(float64(height)*scoreOffset), render.ColorWhite)
-		_ = renderer.DrawText("Enter your name to save score (or press Q to skip):", startX, int(float64(height)*scoreOffset)+1, render.ColorWhite)
-		if s.showOnBlink {
-			_ = renderer.DrawText(s.name+"_", startX, int(float64(height)*scoreOffset)+2, render.ColorBrightMagenta)
-		} else {
-			_ = renderer.DrawText(s.name, startX, int(float64(height)*scoreOffset)+2, render.ColorBrightMagenta)
-		}
-	} else if s.Score > 0 && s.showOnBlink {
-		_ = renderer.DrawText(
-			fmt.Sprintf("%d | %s > %s", s.Score, s.name, s.GetDetails()),
-			startX,
-			int(float64(height)*scoreOffset),
-			render.ColorMagenta,
-		)
-	}
-
-	// Draw leaderboard
-	leaderboardY := int(float64(height) * leaderboardOffset)
-	_ = renderer.DrawText("Top Scores:", startX, leaderboardY, render.ColorBlue)
-	topScores := s.Leaderboard.TopScores(s.Config.LeaderboardSize)
-	for i, entry := range topScores {
-		_ = renderer.DrawText(fmt.Sprintf("%d | %s > %s", entry.Score, entry.Name, entry.Details), startX, leaderboardY+(i+1)*lineSpacing, render.ColorWhite)
-	}
-
-	// Draw controls
-	controlsY := int(float64(height) * controlsOffset)
-	_ = renderer.DrawText("Controls:", startX, controlsY, render.ColorBlue)
-	_ = renderer.DrawText("Press Q to quit the game", startX, controlsY+lineSpacing, render.ColorWhite)
-	_ = renderer.DrawText("Press ENTER to return to save/return to main menu", startX, controlsY+2*lineSpacing, render.ColorWhite)
-}
-
-func (s *GameOverScene) HandleInput(input core.InputEvent) error {
-	switch input.Rune {
-	case 'q', 'Q':
-		if !s.nameEntered && s.Score > 0 {
-			s.Logger.Info("Skipping leaderboard entry")
-			s.nameEntered = true
-		}
-		return core.ErrQuitGame
-	case core.KeyEnter:
-		if !s.nameEntered {
-			if len(s.name) > 0 && s.Score > 0 {
-				s.nameEntered = true
-				s.Logger.Info("Adding leaderboard entry...", "name", s.name, "score", s.Score)
-				s.Leaderboard.Add(s.name, s.Score, s.GetDetails())
-				err := s.Leaderboard.Save(s.Config.BoardFile)
-				if err != nil {
-					return err
-				}
-			}
-		} else {
-			s.Scenes.ChangeScene(MainMenuSceneID)
-		}
-	case core.KeyBackspace:
-		if !s.nameEntered && len(s.name) > 0 {
-			s.name = s.name[:len(s.name)-1]
-		}
-	default:
-		if !s.nameEntered {
-			// Only allow printable characters
-			if input.Rune >= 32 && input.Rune <= 126 && len(s.name) < s.Config.MaxNameLength {
-				s.name += string(input.Rune)
-			}
-		}
-	}
-	return nil
-}
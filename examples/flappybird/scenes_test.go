@@ -0,0 +1,40 @@
+package flappybird
+
+import (
+	"testing"
+
+	"github.com/kuhree/gg/internal/engine/core"
+)
+
+func TestGravityFixedForTheBird(t *testing.T) {
+	g, err := NewGame(80, 24, t.TempDir(), false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewPlayingScene(g)
+
+	// The bird is created before the game starts
+	if err := s.Update(0.01); err != nil {
+		t.Fatal(err)
+	}
+	gravity := s.world.Gravity.Y
+	if gravity != g.Config.BirdGravity {
+		t.Fatalf("gravity = %v, want %v", gravity, g.Config.BirdGravity)
+	}
+
+	if err := s.HandleInput(core.InputEvent{Rune: ' '}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.HandleInput(core.InputEvent{Rune: '+'}); err != nil {
+		t.Fatal(err)
+	}
+	if s.currentGravity == gravity {
+		t.Fatal("level up didn't change the gravity for the next bird")
+	}
+	if err := s.Update(0.01); err != nil {
+		t.Fatal(err)
+	}
+	if s.world.Gravity.Y != gravity {
+		t.Errorf("gravity changed mid-flight from %v to %v", gravity, s.world.Gravity.Y)
+	}
+}
@@ -13,6 +13,12 @@ import (
 // Intervals for FPS statistics tracking (in seconds)
 var statsIntervals = []float64{5, 10, 30}
 
+// Theme roles for the colors specific to Frames, see render.Theme
+const (
+	roleFPS     = "fps"
+	roleCounter = "counter"
+)
+
 // Game represents the Frames game state and logic
 type Game struct {
 	Width  int
@@ -84,37 +90,40 @@ func (g *Game) Draw() {
 	g.renderer.Clear()
 
 	// Display FPS info
-	_ = g.renderer.DrawText(fmt.Sprintf("Target FPS: %.2f", g.targetFps), 2, 2, render.ColorWhite)
-	_ = g.renderer.DrawText(fmt.Sprintf("Current FPS: %.2f", g.currentFps), 2, 3, render.ColorBlue)
+	_ = g.renderer.DrawText(fmt.Sprintf("Target FPS: %.2f", g.targetFps), 2, 2, g.renderer.Color(render.RoleHUD))
+	_ = g.renderer.DrawText(fmt.Sprintf("Current FPS: %.2f", g.currentFps), 2, 3, g.renderer.ColorAs(roleFPS, render.RoleHUD, render.ColorBlue))
 
 	fpsDiff := g.currentFps - g.targetFps
-	diffColor := render.ColorYellow
+	diffColor := g.renderer.Color(render.RoleWarning)
 	if fpsDiff > 5 {
-		diffColor = render.ColorGreen
+		diffColor = g.renderer.Color(render.RoleSuccess)
 	} else if fpsDiff < -5 {
-		diffColor = render.ColorRed
+		diffColor = g.renderer.Color(render.RoleDanger)
 	}
 	_ = g.renderer.DrawText(fmt.Sprintf("FPS Diff: %+.2f", fpsDiff), 2, 4, diffColor)
 
-	// Display FPS statistics for different intervals
+	// Display FPS statistics for different intervals, in fixed colors that
+	// only tell the intervals apart, or one color on semantic themes
 	colors := []render.Color{render.ColorGreen, render.ColorYellow, render.ColorMagenta}
 	y := 6
 	for i, stats := range g.fpsStats {
-		color := colors[i%len(colors)]
-		_ = g.renderer.DrawText(fmt.Sprintf("%.0f Second Stats:", statsIntervals[i]), 2, y, render.ColorWhite)
+		color := g.renderer.RampColor(render.RoleText, colors[i%len(colors)])
+		_ = g.renderer.DrawText(fmt.Sprintf("%.0f Second Stats:", statsIntervals[i]), 2, y, g.renderer.Color(render.RoleHUD))
 		_ = g.renderer.DrawText(fmt.Sprintf("  Min: %.2f  Max: %.2f  Avg: %.2f",
 			stats.min, stats.max, stats.avg()), 2, y+1, color)
 		y += 3
 	}
 
 	// Display frame count and total time
-	_ = g.renderer.DrawText(fmt.Sprintf("Frames: %d", g.frameCount), 2, 15, render.ColorCyan)
-	_ = g.renderer.DrawText(fmt.Sprintf("Total Time: %.2fs", g.totalTime), 2, 16, render.ColorCyan)
+	_ = g.renderer.DrawText(fmt.Sprintf("Frames: %d", g.frameCount), 2, 15, g.renderer.ColorAs(roleCounter, render.RoleHUD, render.ColorCyan))
+	_ = g.renderer.DrawText(fmt.Sprintf("Total Time: %.2fs", g.totalTime), 2, 16, g.renderer.ColorAs(roleCounter, render.RoleHUD, render.ColorCyan))
 
 	// Display window dimensions
-	_ = g.renderer.DrawText(fmt.Sprintf("Window: %dx%d", g.Width, g.Height), 2, 18, render.ColorWhite)
+	_ = g.renderer.DrawText(fmt.Sprintf("Window: %dx%d", g.Width, g.Height), 2, 18, g.renderer.Color(render.RoleHUD))
 
-	g.renderer.Render()
+	if err := g.renderer.Render(); err != nil {
+		g.logger.Error("Frames game failed to render", "err", err)
+	}
 }
 
 // HandleInput processes user input
@@ -19,6 +19,11 @@ type Config struct {
 
 	StabilityThreshold int
 	StabilityChance    float64
+
+	// World size as a multiple of the screen, panned with the camera
+	WorldScale float64
+	// Fraction of the screen the player can roam before the camera scrolls
+	CameraDeadzone float64
 }
 
 func NewConfig(workDir string) (*Config, error) {
@@ -32,6 +37,8 @@ func NewConfig(workDir string) (*Config, error) {
 		SeedBuffer:         1.0,
 		StabilityThreshold: 500,
 		StabilityChance:    0.90,
+		WorldScale:         2.0,
+		CameraDeadzone:     0.5,
 	}
 
 	err := config.LoadConfig(cfg)
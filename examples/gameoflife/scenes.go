@@ -6,23 +6,25 @@ import (
 	"math/rand"
 
 	"github.com/kuhree/gg/internal/engine/core"
-	"github.com/kuhree/gg/internal/engine/leaderboard"
 	"github.com/kuhree/gg/internal/engine/objects"
 	"github.com/kuhree/gg/internal/engine/render"
+	"github.com/kuhree/gg/internal/engine/scenes"
+	"github.com/kuhree/gg/internal/engine/ui"
+	"github.com/kuhree/gg/internal/utils"
 )
 
+// Theme roles for the colors specific to the Game of Life, see render.Theme
 const (
-	titleOffset = 1.0 / 10
-	lineSpacing = 2
+	// roleInfluenced colors the live cells around the player
+	roleInfluenced = "influenced"
+	roleDeadCell   = "dead_cell"
 )
 
 // BaseScene provides common functionality for all scenes
 type BaseScene struct {
 	*Game
-	sceneName     string
-	blinkTimer    float64
-	blinkInterval float64
-	showOnBlink   bool
+	sceneName string
+	blink     *ui.Blink
 }
 
 // Enter logs when a scene is entered
@@ -35,13 +37,20 @@ func (s *BaseScene) Exit() {
 	s.Logger.Info("Exiting scene", "scene", s.sceneName)
 }
 
+// Pause logs when an overlay is pushed on top of a scene
+func (s *BaseScene) Pause() {
+	s.Logger.Info("Pausing scene", "scene", s.sceneName)
+}
+
+// Resume logs when the overlay on top of a scene is popped
+func (s *BaseScene) Resume() {
+	s.Logger.Info("Resuming scene", "scene", s.sceneName)
+}
+
 // Update is a no-op for scenes that don't need updates
-func (s *BaseScene) Update(dt float64) {
-	s.blinkTimer += dt
-	if s.blinkTimer >= s.blinkInterval {
-		s.blinkTimer = 0
-		s.showOnBlink = !s.showOnBlink
-	}
+func (s *BaseScene) Update(dt float64) error {
+	s.blink.Update(dt)
+	return nil
 }
 
 // HandleInput is a no-op for scenes that don't handle input
@@ -49,11 +58,6 @@ func (s *BaseScene) HandleInput(input core.InputEvent) error {
 	return nil
 }
 
-// MainMenuScene represents the main menu
-type MainMenuScene struct {
-	BaseScene
-}
-
 // PlayingScene represents the main gameplay
 type PlayingScene struct {
 	BaseScene
@@ -64,30 +68,7 @@ type PlayingScene struct {
 	stableGenerations  int
 	stableOscillations int
 	boardStates        []uint64
-}
-
-// PauseMenuScene represents the pause menu
-type PauseMenuScene struct {
-	BaseScene
-}
-
-// GameOverScene represents the game over screen
-type GameOverScene struct {
-	BaseScene
-	name        string
-	nameEntered bool
-}
-
-// NewMainMenuScene creates a new main menu scene
-func NewMainMenuScene(game *Game) *MainMenuScene {
-	return &MainMenuScene{
-		BaseScene: BaseScene{
-			Game:          game,
-			sceneName:     "Main Menu",
-			blinkInterval: 0.5,
-			showOnBlink:   true,
-		},
-	}
+	camera             *render.Camera
 }
 
 // NewPlayingScene creates a new playing scene
@@ -102,10 +83,9 @@ func NewPlayingScene(game *Game) *PlayingScene {
 
 	scene := &PlayingScene{
 		BaseScene: BaseScene{
-			Game:          game,
-			sceneName:     "Playing",
-			blinkInterval: 0.5,
-			showOnBlink:   true,
+			Game:      game,
+			sceneName: "Playing",
+			blink:     ui.NewBlink(0.5),
 		},
 
 		playerPos:          playerPos,
@@ -115,122 +95,79 @@ func NewPlayingScene(game *Game) *PlayingScene {
 		stableGenerations:  0,
 		stableOscillations: 0,
 		boardStates:        make([]uint64, 0, game.Config.StabilityThreshold),
+		camera:             render.NewCamera(width, height),
 	}
 
-	return scene
-}
-
-// NewPauseMenuScene creates a new pause menu scene
-func NewPauseMenuScene(game *Game) *PauseMenuScene {
-	return &PauseMenuScene{
-		BaseScene: BaseScene{
-			Game:          game,
-			sceneName:     "Pause Menu",
-			blinkInterval: 0.5,
-			showOnBlink:   true,
-		},
-	}
-}
-
-// NewGameOverScene creates a new game over scene
-func NewGameOverScene(game *Game) *GameOverScene {
-	return &GameOverScene{
-		BaseScene: BaseScene{
-			Game:          game,
-			sceneName:     "Game Over",
-			blinkInterval: 0.5,
-			showOnBlink:   true,
-		},
-		name:        "",
-		nameEntered: false,
-	}
-}
-
-// MainMenuScene methods
-
-func (s *MainMenuScene) Draw(renderer *render.Renderer) {
-	width, height := s.Size()
-	startX := width / 10
-
-	const (
-		startOffset    = 1.0 / 6
-		controlsOffset = 2.0 / 8
+	worldWidth, worldHeight := scene.worldSize()
+	scene.camera.Bounds = render.Rect{Width: worldWidth, Height: worldHeight}
+	scene.camera.SetDeadzone(
+		int(float64(width)*game.Config.CameraDeadzone),
+		int(float64(height)*game.Config.CameraDeadzone),
 	)
 
-	_ = renderer.DrawText(fmt.Sprintf("%s - %s", s.Config.Title, s.sceneName), startX, int(float64(height)*titleOffset), render.ColorWhite)
+	return scene
+}
 
-	if s.showOnBlink {
-		_ = renderer.DrawText("Press ENTER to start", startX, int(float64(height)*startOffset), render.ColorBrightMagenta)
+// PlayingScene methods
+func (s *PlayingScene) Update(dt float64) error {
+	if err := s.BaseScene.Update(dt); err != nil {
+		return err
 	}
 
-	controlsY := int(float64(height) * controlsOffset)
-	_ = renderer.DrawText("Controls:", startX, controlsY, render.ColorBlue)
-	_ = renderer.DrawText("ESC to pause", startX, controlsY+3*lineSpacing, render.ColorWhite)
-	_ = renderer.DrawText("Q to pause/quit", startX, controlsY+4*lineSpacing, render.ColorWhite)
-}
-
-func (s *MainMenuScene) HandleInput(input core.InputEvent) error {
-	switch input.Rune {
-	case core.KeyEnter:
-		s.Logger.Info("Starting new game")
-		s.Scenes.ChangeScene(PlayingSceneID)
-		return nil
-	case 'q', 'Q':
-		s.Scenes.ChangeScene(GameOverSceneID)
-		return core.ErrQuitGame
+	s.updateCollisions(dt)
+	if err := s.checkGameState(); err != nil {
+		return err
 	}
+	s.SetLevel(s.CurrentLevel + 1)
 
+	s.camera.Follow(s.playerPos.X, s.playerPos.Y)
+	s.camera.Update(dt)
 	return nil
 }
 
-// PlayingScene methods
-func (s *PlayingScene) Update(dt float64) {
-	s.BaseScene.Update(dt)
-
-	s.updateCollisions(dt)
-	s.checkGameState()
-	s.CurrentLevel++
-}
-
 func (s *PlayingScene) Draw(renderer *render.Renderer) {
-	width, height := s.Size()
+	worldWidth, worldHeight := s.worldSize()
+	view := s.camera.View()
 	influencedCells := s.getPlayerInfluencedCells()
 
-	for y := 0; y < height; y++ {
-		for x := 0; x < width; x++ {
+	s.camera.Begin(renderer)
+	for y := max(view.Y, 0); y < min(view.Y+view.Height, worldHeight); y++ {
+		for x := max(view.X, 0); x < min(view.X+view.Width, worldWidth); x++ {
 			c := s.getOrCreateCell(x, y)
 			neighbors := s.countNeighbors(x, y)
 			char, color := s.getCellInfo(float64(neighbors), float64(s.Config.BaseNeighboars))
+			color = renderer.RampColor(render.RoleText, color)
 
 			pos := objects.Vector2D{X: float64(x), Y: float64(y)}
 			isInfluenced := contains(influencedCells, pos)
 
 			if c.Alive {
 				if isInfluenced {
-					_ = renderer.DrawRect(x, y, s.Config.BaseSize, s.Config.BaseSize, char, render.ColorBrightCyan)
+					_ = renderer.DrawRect(x, y, s.Config.BaseSize, s.Config.BaseSize, char, renderer.ColorAs(roleInfluenced, render.RoleAccent, render.ColorBrightCyan))
 				} else {
 					_ = renderer.DrawRect(x, y, s.Config.BaseSize, s.Config.BaseSize, char, color)
 				}
 			} else {
-				_ = renderer.DrawRect(x, y, s.Config.BaseSize, s.Config.BaseSize, ' ', render.ColorBrightBlack)
+				_ = renderer.DrawRect(x, y, s.Config.BaseSize, s.Config.BaseSize, ' ', renderer.ColorAs(roleDeadCell, render.RoleBackground, render.ColorBrightBlack))
 			}
 
-			s.drawObjOverlay(x, y, c, color)
+			s.drawObjOverlay(renderer, x, y, c, color)
 		}
 	}
 
 	// Draw player
 	playerX := int(s.playerPos.X)
 	playerY := int(s.playerPos.Y)
-	_ = renderer.DrawRect(playerX, playerY, s.Config.BaseSize, s.Config.BaseSize, '@', render.ColorGreen)
+	_ = renderer.DrawRect(playerX, playerY, s.Config.BaseSize, s.Config.BaseSize, '@', renderer.ColorOr(render.RolePlayer, render.ColorGreen))
+	s.camera.End(renderer)
 
 	// Draw score, level, lives...
-	_ = renderer.DrawText(fmt.Sprintf("Alive: %d", s.Score), 1, 1, render.ColorWhite)
-	_ = renderer.DrawText(fmt.Sprintf("Level: %d", s.CurrentLevel), 1, 2, render.ColorWhite)
+	_ = renderer.DrawText(fmt.Sprintf("Alive: %d", s.Score), 1, 1, renderer.Color(render.RoleHUD))
+	_ = renderer.DrawText(fmt.Sprintf("Level: %d", s.CurrentLevel), 1, 2, renderer.Color(render.RoleHUD))
 	if s.Overlay || s.Debug {
-		_ = renderer.DrawText(fmt.Sprintf("Position: (%.1f, %.1f)", s.playerPos.X, s.playerPos.Y), 1, 3, render.ColorWhite)
-		_ = renderer.DrawText(fmt.Sprintf("Stable Generations: %d / %d", s.stableGenerations, s.Config.StabilityThreshold), 1, 4, render.ColorWhite)
-		_ = renderer.DrawText(fmt.Sprintf("Stable Oscillations: %d / %d", s.stableOscillations, s.Config.StabilityThreshold/2), 1, 5, render.ColorWhite)
+		_ = renderer.DrawText(fmt.Sprintf("Position: (%.1f, %.1f) Camera: (%.1f, %.1f)", s.playerPos.X, s.playerPos.Y, s.camera.X, s.camera.Y), 1, 3, renderer.Color(render.RoleHUD))
+		_ = renderer.DrawText(fmt.Sprintf("Stable Generations: %d / %d", s.stableGenerations, s.Config.StabilityThreshold), 1, 4, renderer.Color(render.RoleHUD))
+		_ = renderer.DrawText(fmt.Sprintf("Stable Oscillations: %d / %d", s.stableOscillations, s.Config.StabilityThreshold/2), 1, 5, renderer.Color(render.RoleHUD))
 	}
 }
 
@@ -243,17 +180,17 @@ func (s *PlayingScene) HandleInput(input core.InputEvent) error {
 	case '2', core.KeyF2:
 		s.Overlay = !s.Overlay
 	case core.KeyW:
-		s.playerPos.Y -= moveSpeed
+		s.movePlayer(0, -moveSpeed)
 	case core.KeyS:
-		s.playerPos.Y += moveSpeed
+		s.movePlayer(0, moveSpeed)
 	case core.KeyA:
-		s.playerPos.X -= moveSpeed
+		s.movePlayer(-moveSpeed, 0)
 	case core.KeyD:
-		s.playerPos.X += moveSpeed
+		s.movePlayer(moveSpeed, 0)
 	case 'p', 'P':
-		s.Scenes.ChangeScene(PauseMenuSceneID)
+		return s.Scenes.Push(PauseMenuSceneID, scenes.Frozen)
 	case 'q', 'Q':
-		s.Scenes.ChangeScene(GameOverSceneID)
+		return s.endGame("Quit")
 	}
 
 	return nil
@@ -261,6 +198,20 @@ func (s *PlayingScene) HandleInput(input core.InputEvent) error {
 
 // PlayingScene helpers
 
+// worldSize returns the size of the board, which can be larger than the screen
+func (s *PlayingScene) worldSize() (int, int) {
+	width, height := s.Size()
+	scale := max(s.Config.WorldScale, 1)
+	return int(float64(width) * scale), int(float64(height) * scale)
+}
+
+// movePlayer moves the player, keeping it on the board
+func (s *PlayingScene) movePlayer(dx, dy float64) {
+	worldWidth, worldHeight := s.worldSize()
+	s.playerPos.X = utils.Clamp(s.playerPos.X+dx, 0, float64(worldWidth-1))
+	s.playerPos.Y = utils.Clamp(s.playerPos.Y+dy, 0, float64(worldHeight-1))
+}
+
 func (s *PlayingScene) getOrCreateCell(x, y int) *Cell {
 	pos := objects.Vector2D{X: float64(x), Y: float64(y)}
 	if cell, exists := s.cells[pos]; exists {
@@ -281,7 +232,7 @@ func (s *PlayingScene) getOrCreateCell(x, y int) *Cell {
 
 // updateCollisions detects and handles collisions between game objects
 func (s *PlayingScene) updateCollisions(_ float64) {
-	width, height := s.Size()
+	width, height := s.worldSize()
 
 	newCells := make(map[objects.Vector2D]Cell)
 
@@ -340,12 +291,12 @@ func (s *PlayingScene) countNeighbors(x, y int) int {
 }
 
 // checkGameState determines if the game should end
-func (s *PlayingScene) checkGameState() {
+func (s *PlayingScene) checkGameState() error {
 	// Calculate and store the current board state hash
 	currentHash := s.calculateBoardHash()
 	s.boardStates = append(s.boardStates, currentHash)
 	liveCells := 0
-	width, height := s.Size()
+	width, height := s.worldSize()
 
 	for y := 0; y < height; y++ {
 		for x := 0; x < width; x++ {
@@ -358,7 +309,7 @@ func (s *PlayingScene) checkGameState() {
 
 	s.prevLiveCellCount = s.liveCellCount
 
-	s.Score = liveCells
+	s.SetScore(liveCells)
 	s.liveCellCount = liveCells
 
 	if s.liveCellCount == s.prevLiveCellCount {
@@ -370,8 +321,7 @@ func (s *PlayingScene) checkGameState() {
 	// Check for stable pattern
 	ratio := float64(s.stableGenerations) / float64(s.Config.StabilityThreshold)
 	if ratio >= s.Config.StabilityChance {
-		s.endGame("Stability reached")
-		return
+		return s.endGame("Stability reached")
 	}
 
 	// Check for oscillating patterns
@@ -388,17 +338,17 @@ func (s *PlayingScene) checkGameState() {
 
 	ratio = float64(s.stableOscillations) / float64(s.Config.StabilityThreshold/2)
 	if ratio >= s.Config.StabilityChance {
-		s.endGame("Oscillating pattern detected")
-		return
+		return s.endGame("Oscillating pattern detected")
 	}
 
 	// Increment generation count
-	s.CurrentLevel++
+	s.SetLevel(s.CurrentLevel + 1)
+	return nil
 }
 
-func (s *PlayingScene) endGame(reason string) {
-	s.Logger.Info("Game over", "reason", reason, "score", s.Score, "level", s.CurrentLevel+1)
-	s.Scenes.ChangeScene(GameOverSceneID)
+func (s *PlayingScene) endGame(reason string) error {
+	s.GameOver(reason)
+	return s.Scenes.ChangeSceneWith(GameOverSceneID, gameOverTransition)
 }
 
 // calculateBoardHash computes a hash of the current board state
@@ -422,8 +372,10 @@ func contains(slice []objects.Vector2D, item objects.Vector2D) bool {
 	return false
 }
 
+// getCellInfo shades a cell by how crowded it is. The colors run through
+// the spectrum as a heat map rather than mean anything, so themes leave them
+// alone apart from semantic themes, which collapse them in Draw.
 func (s *PlayingScene) getCellInfo(neighbors float64, maxNeighbors float64) (rune, render.Color) {
-
 	ratio := neighbors / float64(maxNeighbors)
 	switch {
 	case ratio >= 0.875:
@@ -448,25 +400,21 @@ func (s *PlayingScene) getCellInfo(neighbors float64, maxNeighbors float64) (run
 // getPlayerInfluencedCells returns a list of cell positions influenced by the player
 func (s *PlayingScene) getPlayerInfluencedCells() []objects.Vector2D {
 	influencedCells := make([]objects.Vector2D, 0)
-	width, height := s.Size()
-	radius := s.Config.BaseRadius
-	buffer := s.Config.BaseRadius * s.Config.SeedBuffer
+	width, height := s.worldSize()
+	reach := int(s.Config.BaseRadius + s.Config.BaseRadius*s.Config.SeedBuffer)
 	playerX, playerY := int(s.playerPos.X), int(s.playerPos.Y)
 
-	for y := 0; y < height; y++ {
-		for x := 0; x < width; x++ {
-			distX := math.Abs(float64(x) - float64(playerX))
-			distY := math.Abs(float64(y) - float64(playerY))
-			if distX <= radius+buffer && distY <= radius+buffer {
-				influencedCells = append(influencedCells, objects.Vector2D{X: float64(x), Y: float64(y)})
-			}
+	// Only the square around the player can be within reach
+	for y := max(playerY-reach, 0); y <= min(playerY+reach, height-1); y++ {
+		for x := max(playerX-reach, 0); x <= min(playerX+reach, width-1); x++ {
+			influencedCells = append(influencedCells, objects.Vector2D{X: float64(x), Y: float64(y)})
 		}
 	}
 
 	return influencedCells
 }
 
-func (s *PlayingScene) drawObjOverlay(x, y int, cell *Cell, color render.Color) {
+func (s *PlayingScene) drawObjOverlay(renderer *render.Renderer, x, y int, cell *Cell, color render.Color) {
 	if !s.Overlay && !s.Debug {
 		return
 	}
@@ -476,7 +424,7 @@ func (s *PlayingScene) drawObjOverlay(x, y int, cell *Cell, color render.Color)
 		if cell.Alive {
 			char = '1'
 		}
-		_ = s.Renderer.DrawChar(char, x, y, color)
+		_ = renderer.DrawChar(char, x, y, color)
 	}
 
 	if s.Debug {
@@ -485,153 +433,7 @@ func (s *PlayingScene) drawObjOverlay(x, y int, cell *Cell, color render.Color)
 			fmt.Sprintf("S:%.1fW,%.1fH", cell.Width, cell.Height),
 		}
 		for i, info := range debugInfo {
-			_ = s.Renderer.DrawText(info, x, y+i, color)
+			_ = renderer.DrawText(info, x, y+i, color)
 		}
 	}
 }
-
-// PauseMenuScene methods
-
-func (s *PauseMenuScene) Draw(renderer *render.Renderer) {
-	const (
-		scoreOffset    = 1.0 / 6
-		controlsOffset = 1.0 / 4
-	)
-
-	width, height := s.Size()
-	startX := width / 10
-
-	// Draw title
-	_ = renderer.DrawText(fmt.Sprintf("%s - %s", s.Config.Title, s.sceneName), startX, int(float64(height)*titleOffset), render.ColorWhite)
-
-	if s.showOnBlink {
-		_ = renderer.DrawText(
-			fmt.Sprintf("Score: %d | Level: %d", s.Score, s.CurrentLevel),
-			startX,
-			int(float64(height)*scoreOffset),
-			render.ColorMagenta,
-		)
-	}
-
-	// Draw controls
-	controlsY := int(float64(height) * controlsOffset)
-	_ = renderer.DrawText("Controls:", startX, controlsY, render.ColorBlue)
-	_ = renderer.DrawText("Press ESC to resume", startX, controlsY+lineSpacing, render.ColorWhite)
-	_ = renderer.DrawText("Press Q to quit", startX, controlsY+2*lineSpacing, render.ColorWhite)
-}
-
-func (s *PauseMenuScene) HandleInput(input core.InputEvent) error {
-	switch input.Rune {
-	case core.KeyEscape:
-		s.Scenes.ChangeScene(PlayingSceneID)
-	case 'q', 'Q':
-		s.Scenes.ChangeScene(GameOverSceneID)
-		return core.ErrQuitGame
-	}
-
-	return nil
-}
-
-// GameOverScene methods
-
-func (s *GameOverScene) Enter() {
-	s.BaseScene.Enter()
-	err := s.Leaderboard.Load(s.Config.BoardFile)
-	if err != nil {
-		s.Logger.Warn("Failed to load existing leaderboard. Creating a new one...", "path", s.Config.BoardFile, "err", err)
-		s.Leaderboard.Records = make([]leaderboard.Record, 0)
-	}
-}
-
-func (s *GameOverScene) GetDetails() string {
-	width, height := s.Size()
-	return fmt.Sprintf(
-		"%dW*%dH|L%d@%.2fBC|%dBS|%.2fBR|%.2fSB|%dST",
-		width, height,
-		s.CurrentLevel, s.Config.BaseChance, s.Config.BaseSize,
-		s.Config.BaseRadius, s.Config.SeedBuffer, s.Config.StabilityThreshold,
-	)
-}
-
-func (s *GameOverScene) Draw(renderer *render.Renderer) {
-	const (
-		scoreOffset       = 1.0 / 6
-		leaderboardOffset = 1.0 / 4
-		controlsOffset    = 3.0 / 4
-	)
-
-	width, height := s.Size()
-	startX := width / 10
-
-	// Draw title and game over message
-	_ = renderer.DrawText(fmt.Sprintf("%s - %s", s.Config.Title, s.sceneName), startX, int(float64(height)*titleOffset), render.ColorWhite)
-
-	if s.Score > 0 && !s.nameEntered {
-		// Draw name entry prompt and score
-		_ = renderer.DrawText(fmt.Sprintf("Score: %d", s.Score), startX, int(float64(height)*scoreOffset), render.ColorWhite)
-		_ = renderer.DrawText("Enter your name to save score (or press Q to skip):", startX, int(float64(height)*scoreOffset)+1, render.ColorWhite)
-		if s.showOnBlink {
-			_ = renderer.DrawText(s.name+"_", startX, int(float64(height)*scoreOffset)+2, render.ColorBrightMagenta)
-		} else {
-			_ = renderer.DrawText(s.name, startX, int(float64(height)*scoreOffset)+2, render.ColorBrightMagenta)
-		}
-	} else if s.Score > 0 && s.showOnBlink {
-		_ = renderer.DrawText(
-			fmt.Sprintf("%d | %s > %s", s.Score, s.name, s.GetDetails()),
-			startX,
-			int(float64(height)*scoreOffset),
-			render.ColorMagenta,
-		)
-	}
-
-	// Draw leaderboard
-	leaderboardY := int(float64(height) * leaderboardOffset)
-	_ = renderer.DrawText("Top Scores:", startX, leaderboardY, render.ColorBlue)
-	topScores := s.Leaderboard.TopScores(5)
-	for i, entry := range topScores {
-		_ = renderer.DrawText(fmt.Sprintf("%d | %s > %s", entry.Score, entry.Name, entry.Details), startX, leaderboardY+(i+1)*lineSpacing, render.ColorWhite)
-	}
-
-	// Draw controls
-	controlsY := int(float64(height) * controlsOffset)
-	_ = renderer.DrawText("Controls:", startX, controlsY, render.ColorBlue)
-	_ = renderer.DrawText("Press Q to quit the game", startX, controlsY+lineSpacing, render.ColorWhite)
-	_ = renderer.DrawText("Press ENTER to return to save/return to main menu", startX, controlsY+2*lineSpacing, render.ColorWhite)
-}
-
-func (s *GameOverScene) HandleInput(input core.InputEvent) error {
-	switch input.Rune {
-	case 'q', 'Q':
-		if !s.nameEntered && s.Score > 0 {
-			s.Logger.Info("Skipping leaderboard entry")
-			s.nameEntered = true
-		}
-		return core.ErrQuitGame
-	case core.KeyEnter:
-		if !s.nameEntered {
-			if len(s.name) > 0 && s.Score > 0 {
-				s.nameEntered = true
-				s.Logger.Info("Adding leaderboard entry...", "name", s.name, "score", s.Score)
-				s.Leaderboard.Add(s.name, s.Score, s.GetDetails())
-				err := s.Leaderboard.Save(s.Config.BoardFile)
-				if err != nil {
-					return err
-				}
-			}
-		} else {
-			s.Scenes.ChangeScene(MainMenuSceneID)
-		}
-	case core.KeyBackspace:
-		if !s.nameEntered && len(s.name) > 0 {
-			s.name = s.name[:len(s.name)-1]
-		}
-	default:
-		if !s.nameEntered {
-			// Only allow printable characters
-			if input.Rune >= 32 && input.Rune <= 126 && len(s.name) < 12 {
-				s.name += string(input.Rune)
-			}
-		}
-	}
-	return nil
-}
@@ -65,7 +65,9 @@ func (g *Game) Init() error {
 	g.Logger.Info(fmt.Sprintf("%s - Adding Scenes", g.Config.Title))
 	g.Scenes.AddScene(MainMenuSceneID, NewMainMenuScene(g))
 	g.Scenes.AddScene(VisualizerSceneID, NewVisualizerScene(g))
-	g.Scenes.ChangeScene(MainMenuSceneID)
+	if err := g.Scenes.ChangeScene(MainMenuSceneID); err != nil {
+		return err
+	}
 
 	g.Logger.Info(fmt.Sprintf("%s - Game initialized", g.Config.Title))
 	return nil
@@ -75,6 +77,11 @@ func (g *Game) Cleanup() {
 	g.Logger.Info(fmt.Sprintf("%s - Game cleaned up", g.Config.Title))
 }
 
+// Screenshot saves the last rendered frame into the game directory
+func (g *Game) Screenshot() ([]string, error) {
+	return g.Renderer.SaveScreenshot(g.Config.GameDir)
+}
+
 func (g *Game) Size() (int, int) {
 	return g.Width, g.Height
 }
@@ -82,12 +89,13 @@ func (g *Game) Size() (int, int) {
 func (g *Game) Draw() {
 	g.Renderer.Clear()
 	g.Scenes.Draw(g.Renderer)
-	g.Renderer.Render()
+	if err := g.Renderer.Render(); err != nil {
+		g.Logger.Error(fmt.Sprintf("%s - Failed to render", g.Config.Title), "err", err)
+	}
 }
 
 func (g *Game) Update(dt float64) error {
-	g.Scenes.Update(dt)
-	return nil
+	return g.Scenes.Update(dt)
 }
 
 func (g *Game) HandleInput(input core.InputEvent) error {
@@ -0,0 +1,27 @@
+package sorts
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kuhree/gg/internal/engine/golden"
+)
+
+// goldenSizes are the screens the menu is checked on, a typical terminal and a cramped one
+var goldenSizes = [][2]int{{80, 24}, {50, 16}}
+
+func TestMenusGolden(t *testing.T) {
+	for _, size := range goldenSizes {
+		width, height := size[0], size[1]
+		g, err := NewGame(width, height, t.TempDir(), false, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := g.Init(); err != nil {
+			t.Fatal(err)
+		}
+
+		frame := golden.Draw(t, width, height, g.Scenes.Scene(MainMenuSceneID).Draw)
+		golden.Assert(t, fmt.Sprintf("main_menu_%dx%d", width, height), frame, golden.WithColors())
+	}
+}
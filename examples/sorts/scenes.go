@@ -6,14 +6,17 @@ import (
 
 	"github.com/kuhree/gg/internal/engine/core"
 	"github.com/kuhree/gg/internal/engine/render"
+	"github.com/kuhree/gg/internal/engine/timing"
+	"github.com/kuhree/gg/internal/engine/ui"
 )
 
+// roleBar is the theme role for the bars being sorted, see render.Theme
+const roleBar = "bar"
+
 type BaseScene struct {
 	*Game
-	sceneName     string
-	blinkTimer    float64
-	blinkInterval float64
-	showOnBlink   bool
+	sceneName string
+	blink     *ui.Blink
 }
 
 func (s *BaseScene) Enter() {
@@ -24,12 +27,9 @@ func (s *BaseScene) Exit() {
 	s.Logger.Info("Exiting scene", "scene", s.sceneName)
 }
 
-func (s *BaseScene) Update(dt float64) {
-	s.blinkTimer += dt
-	if s.blinkTimer >= s.blinkInterval {
-		s.blinkTimer = 0
-		s.showOnBlink = !s.showOnBlink
-	}
+func (s *BaseScene) Update(dt float64) error {
+	s.blink.Update(dt)
+	return nil
 }
 
 func (s *BaseScene) HandleInput(input core.InputEvent) error {
@@ -43,10 +43,9 @@ type MainMenuScene struct {
 func NewMainMenuScene(game *Game) *MainMenuScene {
 	return &MainMenuScene{
 		BaseScene: BaseScene{
-			Game:          game,
-			sceneName:     "Main Menu",
-			blinkInterval: 0.5,
-			showOnBlink:   true,
+			Game:      game,
+			sceneName: "Main Menu",
+			blink:     ui.NewBlink(0.5),
 		},
 	}
 }
@@ -64,7 +63,7 @@ func (s *MainMenuScene) Draw(renderer *render.Renderer) {
 	for y := 0; y < boxHeight; y++ {
 		for x := 0; x < boxWidth; x++ {
 			char := ' '
-			color := render.ColorBlack
+			color := renderer.Color(render.RoleBackground)
 
 			// Draw borders
 			if y == 0 || y == boxHeight-1 {
@@ -73,10 +72,10 @@ func (s *MainMenuScene) Draw(renderer *render.Renderer) {
 				} else {
 					char = '-'
 				}
-				color = render.ColorBlue
+				color = renderer.Color(render.RoleHeading)
 			} else if x == 0 || x == boxWidth-1 {
 				char = '|'
-				color = render.ColorBlue
+				color = renderer.Color(render.RoleHeading)
 			}
 
 			_ = renderer.DrawChar(char, boxStartX+x, boxStartY+y, color)
@@ -85,30 +84,27 @@ func (s *MainMenuScene) Draw(renderer *render.Renderer) {
 
 	// Draw title centered in box
 	title := fmt.Sprintf("%s - %s", s.Config.Title, s.sceneName)
-	titleX := boxStartX + (boxWidth-len(title))/2
-	_ = renderer.DrawText(title, titleX, boxStartY+2, render.ColorWhite)
+	_ = renderer.DrawTextAligned(title, boxStartX+1, boxStartY+2, boxWidth-2, render.AlignCenter, renderer.Color(render.RoleTitle))
 
 	// Draw blinking start message
-	if s.showOnBlink {
-		startMsg := "Press ENTER to start"
-		startX := boxStartX + (boxWidth-len(startMsg))/2
-		_ = renderer.DrawText(startMsg, startX, boxStartY+4, render.ColorBrightMagenta)
+	if s.blink.On() {
+		_ = renderer.DrawTextAligned("Press ENTER to start", boxStartX+1, boxStartY+4, boxWidth-2, render.AlignCenter, renderer.Color(render.RoleAccent))
 	}
 
 	// Draw controls section
 	controlsY := boxStartY + 6
 	controlsX := boxStartX + 3
-	_ = renderer.DrawText("Controls:", controlsX, controlsY, render.ColorBlue)
-	_ = renderer.DrawText("1-3: Select sorting algorithm", controlsX, controlsY+1, render.ColorWhite)
-	_ = renderer.DrawText("R: Reset array", controlsX, controlsY+2, render.ColorWhite)
-	_ = renderer.DrawText("SPACE: Start/Pause sort", controlsX, controlsY+3, render.ColorWhite)
-	_ = renderer.DrawText("Q: Quit", controlsX, controlsY+4, render.ColorWhite)
+	_ = renderer.DrawText("Controls:", controlsX, controlsY, renderer.Color(render.RoleHeading))
+	_ = renderer.DrawText("1-3: Select sorting algorithm", controlsX, controlsY+1, renderer.Color(render.RoleText))
+	_ = renderer.DrawText("R: Reset array", controlsX, controlsY+2, renderer.Color(render.RoleText))
+	_ = renderer.DrawText("SPACE: Start/Pause sort", controlsX, controlsY+3, renderer.Color(render.RoleText))
+	_ = renderer.DrawText("Q: Quit", controlsX, controlsY+4, renderer.Color(render.RoleText))
 }
 
 func (s *MainMenuScene) HandleInput(input core.InputEvent) error {
 	switch input.Rune {
 	case core.KeyEnter:
-		s.Scenes.ChangeScene(VisualizerSceneID)
+		return s.Scenes.ChangeScene(VisualizerSceneID)
 	case 'q', 'Q':
 		return core.ErrQuitGame
 	}
@@ -117,19 +113,19 @@ func (s *MainMenuScene) HandleInput(input core.InputEvent) error {
 
 type VisualizerScene struct {
 	BaseScene
-	updateTimer float64
+	updateTimer *timing.Timer
 	isPaused    bool
 }
 
 func NewVisualizerScene(game *Game) *VisualizerScene {
 	scene := &VisualizerScene{
 		BaseScene: BaseScene{
-			Game:          game,
-			sceneName:     "Visualizer",
-			blinkInterval: 0.5,
-			showOnBlink:   true,
+			Game:      game,
+			sceneName: "Visualizer",
+			blink:     ui.NewBlink(0.5),
 		},
-		isPaused: true,
+		updateTimer: timing.Every(game.Config.UpdateInterval, nil),
+		isPaused:    true,
 	}
 	scene.resetArray()
 	scene.CurrentSorter = NewQuickSort()
@@ -147,18 +143,19 @@ func (s *VisualizerScene) resetArray() {
 	s.SortComplete = false
 }
 
-func (s *VisualizerScene) Update(dt float64) {
-	s.BaseScene.Update(dt)
+func (s *VisualizerScene) Update(dt float64) error {
+	if err := s.BaseScene.Update(dt); err != nil {
+		return err
+	}
 
 	if !s.isPaused && !s.SortComplete {
-		s.updateTimer += dt
 		s.ElapsedTime += dt
 
-		if s.updateTimer >= s.Config.UpdateInterval {
-			s.updateTimer = 0
+		if s.updateTimer.Update(dt) {
 			s.SortComplete = s.CurrentSorter.Step(s.CurrentArray, s.Game)
 		}
 	}
+	return nil
 }
 
 func (s *VisualizerScene) Draw(renderer *render.Renderer) {
@@ -166,7 +163,7 @@ func (s *VisualizerScene) Draw(renderer *render.Renderer) {
 	startX := width / 10
 
 	// Draw title and status
-	_ = renderer.DrawText(fmt.Sprintf("%s - %s", s.Config.Title, s.sceneName), startX, int(float64(height)*s.Config.TitleOffset), render.ColorWhite)
+	_ = renderer.DrawText(fmt.Sprintf("%s - %s", s.Config.Title, s.sceneName), startX, int(float64(height)*s.Config.TitleOffset), renderer.Color(render.RoleTitle))
 
 	// Draw array visualization
 	maxHeight := height - 10
@@ -175,16 +172,16 @@ func (s *VisualizerScene) Draw(renderer *render.Renderer) {
 		x := startX + int(float64(i)*s.Config.BarWidth)
 
 		for y := 0; y < barHeight; y++ {
-			_ = renderer.DrawChar('█', x, height-5-y, render.ColorCyan)
+			_ = renderer.DrawChar('█', x, height-5-y, renderer.ColorAs(roleBar, render.RoleAccent, render.ColorCyan))
 		}
 	}
 
 	// Draw statistics
 	statsY := height - 3
-	_ = renderer.DrawText(fmt.Sprintf("Algorithm: %s", s.CurrentSorter.Name()), startX, statsY, render.ColorWhite)
-	_ = renderer.DrawText(fmt.Sprintf("Comparisons: %d", s.ComparisonCount), startX+30, statsY, render.ColorWhite)
-	_ = renderer.DrawText(fmt.Sprintf("Swaps: %d", s.SwapCount), startX+50, statsY, render.ColorWhite)
-	_ = renderer.DrawText(fmt.Sprintf("Time: %.2fs", s.ElapsedTime), startX+70, statsY, render.ColorWhite)
+	_ = renderer.DrawText(fmt.Sprintf("Algorithm: %s", s.CurrentSorter.Name()), startX, statsY, renderer.Color(render.RoleHUD))
+	_ = renderer.DrawText(fmt.Sprintf("Comparisons: %d", s.ComparisonCount), startX+30, statsY, renderer.Color(render.RoleHUD))
+	_ = renderer.DrawText(fmt.Sprintf("Swaps: %d", s.SwapCount), startX+50, statsY, renderer.Color(render.RoleHUD))
+	_ = renderer.DrawText(fmt.Sprintf("Time: %.2fs", s.ElapsedTime), startX+70, statsY, renderer.Color(render.RoleHUD))
 
 	if s.SortComplete {
 		// Draw completion stats box with padding and outline
@@ -197,19 +194,19 @@ func (s *VisualizerScene) Draw(renderer *render.Renderer) {
 		for y := 0; y < boxHeight; y++ {
 			for x := 0; x < boxWidth; x++ {
 				if y == 0 || y == boxHeight-1 || x == 0 || x == boxWidth-1 {
-					_ = renderer.DrawChar('█', boxStartX+x, boxStartY+y, render.ColorWhite)
+					_ = renderer.DrawChar('█', boxStartX+x, boxStartY+y, renderer.Color(render.RoleText))
 				} else {
-					_ = renderer.DrawChar(' ', boxStartX+x, boxStartY+y, render.ColorBlack)
+					_ = renderer.DrawChar(' ', boxStartX+x, boxStartY+y, renderer.Color(render.RoleBackground))
 				}
 			}
 		}
 
 		// Draw completion stats with padding
-		_ = renderer.DrawText("Sort Complete!", boxStartX+3, boxStartY+2, render.ColorWhite)
-		_ = renderer.DrawText(fmt.Sprintf("Algorithm: %s", s.CurrentSorter.Name()), boxStartX+3, boxStartY+3, render.ColorWhite)
-		_ = renderer.DrawText(fmt.Sprintf("Comparisons: %d", s.ComparisonCount), boxStartX+3, boxStartY+4, render.ColorWhite)
-		_ = renderer.DrawText(fmt.Sprintf("Swaps: %d", s.SwapCount), boxStartX+3, boxStartY+5, render.ColorWhite)
-		_ = renderer.DrawText(fmt.Sprintf("Time: %.2fs", s.ElapsedTime), boxStartX+3, boxStartY+6, render.ColorWhite)
+		_ = renderer.DrawText("Sort Complete!", boxStartX+3, boxStartY+2, renderer.Color(render.RoleTitle))
+		_ = renderer.DrawText(fmt.Sprintf("Algorithm: %s", s.CurrentSorter.Name()), boxStartX+3, boxStartY+3, renderer.Color(render.RoleText))
+		_ = renderer.DrawText(fmt.Sprintf("Comparisons: %d", s.ComparisonCount), boxStartX+3, boxStartY+4, renderer.Color(render.RoleText))
+		_ = renderer.DrawText(fmt.Sprintf("Swaps: %d", s.SwapCount), boxStartX+3, boxStartY+5, renderer.Color(render.RoleText))
+		_ = renderer.DrawText(fmt.Sprintf("Time: %.2fs", s.ElapsedTime), boxStartX+3, boxStartY+6, renderer.Color(render.RoleText))
 	}
 }
 
@@ -229,7 +226,7 @@ func (s *VisualizerScene) HandleInput(input core.InputEvent) error {
 	case ' ':
 		s.isPaused = !s.isPaused
 	case 'q', 'Q':
-		s.Scenes.ChangeScene(MainMenuSceneID)
+		return s.Scenes.ChangeScene(MainMenuSceneID)
 	}
 	return nil
 }
@@ -0,0 +1,36 @@
+package space_invaders
+
+import (
+	"embed"
+
+	"github.com/kuhree/gg/internal/engine/render"
+)
+
+//go:embed assets/*.sprite
+var assets embed.FS
+
+// alienSpriteNames maps each alien type to its sprite asset
+var alienSpriteNames = map[AlienType]string{
+	BasicAlien:   "basic",
+	FastAlien:    "fast",
+	ToughAlien:   "tough",
+	ShooterAlien: "shooter",
+	BossAlien:    "boss",
+}
+
+// loadAlienAnimations loads the marching animation for every alien type.
+// All aliens of a type share one player so they march in step.
+func loadAlienAnimations() (map[AlienType]*render.Animation, error) {
+	sprites, err := render.LoadSprites(assets, "assets/*.sprite")
+	if err != nil {
+		return nil, err
+	}
+
+	animations := make(map[AlienType]*render.Animation, len(alienSpriteNames))
+	for alienType, name := range alienSpriteNames {
+		if sprite, ok := sprites[name]; ok {
+			animations[alienType] = render.NewAnimation(sprite, true)
+		}
+	}
+	return animations, nil
+}
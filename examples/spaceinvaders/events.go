@@ -0,0 +1,17 @@
+package space_invaders
+
+// AlienKilled is emitted when the player shoots down an alien
+type AlienKilled struct {
+	Alien *Alien
+}
+
+// PlayerHit is emitted when the player takes damage from an alien or a projectile
+type PlayerHit struct {
+	Damage float64
+	Source *Object
+}
+
+// WaveCleared is emitted when every alien of the current level is gone
+type WaveCleared struct {
+	Level int
+}
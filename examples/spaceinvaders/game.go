@@ -5,10 +5,13 @@ import (
 	"log/slog"
 
 	"github.com/kuhree/gg/internal/engine/core"
+	"github.com/kuhree/gg/internal/engine/events"
 	"github.com/kuhree/gg/internal/engine/leaderboard"
+	"github.com/kuhree/gg/internal/engine/menus"
 	"github.com/kuhree/gg/internal/engine/objects"
 	"github.com/kuhree/gg/internal/engine/render"
 	"github.com/kuhree/gg/internal/engine/scenes"
+	"github.com/kuhree/gg/internal/engine/timing"
 	"github.com/kuhree/gg/internal/utils"
 )
 
@@ -19,6 +22,13 @@ const (
 	GameOverSceneID
 )
 
+// Transitions into gameplay, out of it and back to the menu
+var (
+	startTransition    = scenes.Transition{Style: scenes.Iris, Duration: 0.6, Easing: timing.EaseOut}
+	gameOverTransition = scenes.Transition{Style: scenes.Dissolve, Duration: 0.8, Easing: timing.EaseIn}
+	menuTransition     = scenes.Transition{Style: scenes.Fade, Duration: 0.5}
+)
+
 // Game represents the Space Invaders game state and logic
 
 type Game struct {
@@ -26,6 +36,7 @@ type Game struct {
 	Width       int
 	Height      int
 	Renderer    *render.Renderer
+	Effects     *render.Effects
 	Logger      *slog.Logger
 	Scenes      *scenes.Manager
 	Leaderboard *leaderboard.Board
@@ -35,9 +46,8 @@ type Game struct {
 	Debug   bool
 	Overlay bool
 
-	// Game-specific state
-	Score        int
-	CurrentLevel int
+	// Score and level, every change is published on the event bus
+	events.Stats
 
 	// Game-specific objects
 	Player            *Player
@@ -47,6 +57,7 @@ type Game struct {
 	BarriersCountLast int
 	Collectables      []*Collectable
 	ActiveEffects     map[CollectableType]float64
+	AlienAnimations   map[AlienType]*render.Animation
 }
 
 // NewGame creates a new instance of the Space Invaders game
@@ -70,18 +81,26 @@ func NewGame(width, height int, workDir string, debug bool, overlay bool) (*Game
 		logger.Info("Board loaded!", "path", config.BoardFile, "board", board)
 	}
 
+	animations, err := loadAlienAnimations()
+	if err != nil {
+		return nil, err
+	}
+
 	game := &Game{
-		Width:         width,
-		Height:        height,
-		Renderer:      renderer,
-		Logger:        logger,
-		Config:        config,
-		Leaderboard:   board,
-		Debug:         debug,
-		Collectables:  make([]*Collectable, 0),
-		ActiveEffects: make(map[CollectableType]float64),
-		Overlay:       overlay,
-		Scenes:        scenes,
+		Width:           width,
+		Height:          height,
+		Renderer:        renderer,
+		Effects:         render.NewEffects(),
+		Stats:           events.NewStats(),
+		Logger:          logger,
+		Config:          config,
+		Leaderboard:     board,
+		Debug:           debug,
+		Collectables:    make([]*Collectable, 0),
+		ActiveEffects:   make(map[CollectableType]float64),
+		AlienAnimations: animations,
+		Overlay:         overlay,
+		Scenes:          scenes,
 		Player: &Player{
 			Object: Object{
 				GameObject: objects.GameObject{
@@ -106,17 +125,64 @@ func (g *Game) Init() error {
 	g.Logger.Info(fmt.Sprintf("%s - Game initializing...", g.Config.Title))
 
 	g.Logger.Info(fmt.Sprintf("%s - Adding Scenes", g.Config.Title))
-	g.Scenes.AddScene(MainMenuSceneID, NewMainMenuScene(g))
+	ctx := menus.Context{Title: g.Config.Title, Scenes: g.Scenes, Logger: g.Logger}
+	g.Scenes.AddScene(MainMenuSceneID, menus.NewMainMenu(ctx, menus.MainMenuConfig{
+		Controls: []string{
+			"Arrow keys / WASD to move",
+			"SPACE to shoot",
+			"ESC to pause",
+			"Q to pause/quit",
+		},
+		Start:      g.newGame,
+		Next:       PlayingSceneID,
+		Transition: startTransition,
+	}))
 	g.Scenes.AddScene(PlayingSceneID, NewPlayingScene(g))
-	g.Scenes.AddScene(GameOverSceneID, NewGameOverScene(g))
-	g.Scenes.AddScene(PauseMenuSceneID, NewPauseMenuScene(g))
-	g.Scenes.ChangeScene(MainMenuSceneID)
+	g.Scenes.AddScene(GameOverSceneID, menus.NewGameOver(ctx, menus.GameOverConfig{
+		Leaderboard:     g.Leaderboard,
+		BoardFile:       g.Config.BoardFile,
+		LeaderboardSize: 5,
+		MaxNameLength:   12,
+		Score:           func() int { return g.Score },
+		Details:         g.details,
+		Next:            MainMenuSceneID,
+		Transition:      menuTransition,
+	}))
+	g.Scenes.AddScene(PauseMenuSceneID, menus.NewPauseMenu(ctx, menus.PauseMenuConfig{
+		Status: func() string {
+			return fmt.Sprintf("Score: %d | Level: %d | Enemies Remaining | %d", g.Score, g.CurrentLevel, len(g.Aliens))
+		},
+	}))
+	if err := g.Scenes.ChangeScene(MainMenuSceneID); err != nil {
+		return err
+	}
 	g.Logger.Info(fmt.Sprintf("%s - Scenes loaded!", g.Config.Title), "startScene", MainMenuSceneID)
 
 	g.Logger.Info(fmt.Sprintf("%s - Game initialized", g.Config.Title))
 	return nil
 }
 
+// newGame resets the score, level and player for a new game
+func (g *Game) newGame() error {
+	g.Player.Health = g.Config.BasePlayerHealth
+	g.Player.MaxHealth = g.Config.BasePlayerHealth
+	g.Player.Lives = g.Config.BaseLives
+	g.Start(g.Config.Title, g.Config.BaseScore, g.Config.BaseLevel-g.Config.BaseLevelStep)
+	return nil
+}
+
+// details describes the settings a score was reached with, saved alongside it
+func (g *Game) details() string {
+	width, height := g.Size()
+	return fmt.Sprintf(
+		"%dW*%dH|L%d@%dBL|%.1fBH|%.1fBAH|(%.2fBD * %.1fBDM)|%dBS",
+		width, height,
+		g.CurrentLevel, g.Config.BaseLevel,
+		g.Config.BasePlayerHealth, g.Config.BaseAlienHealth,
+		g.Config.BaseDifficulty, g.Config.BaseDifficultyMultiplier, g.Config.BaseScore,
+	)
+}
+
 // Cleanup performs any necessary cleanup
 func (g *Game) Cleanup() {
 	if g.Score > 0 {
@@ -131,6 +197,11 @@ func (g *Game) Cleanup() {
 	g.Logger.Info(fmt.Sprintf("%s - Game cleaned up", g.Config.Title))
 }
 
+// Screenshot saves the last rendered frame into the game directory
+func (g *Game) Screenshot() ([]string, error) {
+	return g.Renderer.SaveScreenshot(g.Config.GameDir)
+}
+
 func (g *Game) Size() (int, int) {
 	return g.Width, g.Height
 }
@@ -139,13 +210,17 @@ func (g *Game) Size() (int, int) {
 func (g *Game) Draw() {
 	g.Renderer.Clear()
 	g.Scenes.Draw(g.Renderer)
-	g.Renderer.Render()
+	g.Effects.Apply(g.Renderer)
+	if err := g.Renderer.Render(); err != nil {
+		g.Logger.Error(fmt.Sprintf("%s - Failed to render", g.Config.Title), "err", err)
+	}
 }
 
 // Update updates the game state
 func (g *Game) Update(dt float64) error {
-	g.Scenes.Update(dt)
-	return nil
+	err := g.Scenes.Update(dt)
+	g.Effects.Update(dt)
+	return err
 }
 
 // HandleInput processes user input
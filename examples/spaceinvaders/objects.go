@@ -1,6 +1,10 @@
 package space_invaders
 
-import "github.com/kuhree/gg/internal/engine/objects"
+import (
+	"github.com/kuhree/gg/internal/engine/objects"
+	"github.com/kuhree/gg/internal/engine/objects/collision"
+	"github.com/kuhree/gg/internal/engine/timing"
+)
 
 // Object represents a basic game entity
 type Object struct {
@@ -11,6 +15,11 @@ type Object struct {
 	MaxHealth float64
 }
 
+// Bounds returns the box the object covers around its position
+func (o *Object) Bounds() collision.AABB {
+	return collision.Centered(o.Position, o.Width, o.Height)
+}
+
 func (o *Object) Size() float64 {
 	return o.Height * o.Width
 }
@@ -29,7 +38,7 @@ type Alien struct {
 	Object
 
 	AlienType     AlienType
-	shootCooldown float64
+	shootTimer    *timing.Timer
 	shootInterval float64
 	shootChance   float64
 }
@@ -5,22 +5,20 @@ import (
 	"math"
 
 	"github.com/kuhree/gg/internal/engine/core"
-	"github.com/kuhree/gg/internal/engine/leaderboard"
+	"github.com/kuhree/gg/internal/engine/events"
+	"github.com/kuhree/gg/internal/engine/objects/collision"
+	"github.com/kuhree/gg/internal/engine/particles"
 	"github.com/kuhree/gg/internal/engine/render"
-)
-
-const (
-	titleOffset = 1.0 / 10
-	lineSpacing = 2
+	"github.com/kuhree/gg/internal/engine/scenes"
+	"github.com/kuhree/gg/internal/engine/timing"
+	"github.com/kuhree/gg/internal/engine/ui"
 )
 
 // BaseScene provides common functionality for all scenes
 type BaseScene struct {
 	*Game
-	sceneName     string
-	blinkTimer    float64
-	blinkInterval float64
-	showOnBlink   bool
+	sceneName string
+	blink     *ui.Blink
 }
 
 // Enter logs when a scene is entered
@@ -34,13 +32,20 @@ func (s *BaseScene) Exit() {
 	s.Logger.Info("Exiting scene", "scene", s.sceneName)
 }
 
+// Pause logs when an overlay is pushed on top of a scene
+func (s *BaseScene) Pause() {
+	s.Logger.Info("Pausing scene", "scene", s.sceneName)
+}
+
+// Resume logs when the overlay on top of a scene is popped
+func (s *BaseScene) Resume() {
+	s.Logger.Info("Resuming scene", "scene", s.sceneName)
+}
+
 // Update is a no-op for scenes that don't need updates
-func (s *BaseScene) Update(dt float64) {
-	s.blinkTimer += dt
-	if s.blinkTimer >= s.blinkInterval {
-		s.blinkTimer = 0
-		s.showOnBlink = !s.showOnBlink
-	}
+func (s *BaseScene) Update(dt float64) error {
+	s.blink.Update(dt)
+	return nil
 }
 
 // HandleInput is a no-op for scenes that don't handle input
@@ -48,132 +53,85 @@ func (s *BaseScene) HandleInput(input core.InputEvent) error {
 	return nil
 }
 
-// MainMenuScene represents the main menu
-type MainMenuScene struct {
-	BaseScene
-}
+// collisionCellSize is the size of the collision grid cells, about as big as the largest aliens
+const collisionCellSize = 8
 
 // PlayingScene represents the main gameplay
 type PlayingScene struct {
 	BaseScene
-	collectableSpawnTimer float64
-}
+	timers           *timing.Scheduler
+	subscriptions    []events.Handle
+	grid             *collision.Grid[any]
+	collectableSpawn *timing.Timer
 
-// PauseMenuScene represents the pause menu
-type PauseMenuScene struct {
-	BaseScene
-}
+	// nextWave announces the next wave, sliding the banner in to waveBannerY
+	nextWave    *timing.Sequence
+	waveBannerY float64
 
-// GameOverScene represents the game over screen
-type GameOverScene struct {
-	BaseScene
-	name        string
-	nameEntered bool
-}
-
-// NewMainMenuScene creates a new main menu scene
-func NewMainMenuScene(game *Game) *MainMenuScene {
-	return &MainMenuScene{
-		BaseScene: BaseScene{
-			Game:          game,
-			sceneName:     "Main Menu",
-			blinkInterval: 0.5,
-			showOnBlink:   true,
-		},
-	}
+	explosions *particles.Emitter
+	sparks     *particles.Emitter
 }
 
 // NewPlayingScene creates a new playing scene
 func NewPlayingScene(game *Game) *PlayingScene {
 	return &PlayingScene{
 		BaseScene: BaseScene{
-			Game:          game,
-			sceneName:     "Playing",
-			blinkInterval: 0.5,
-			showOnBlink:   true,
+			Game:      game,
+			sceneName: "Playing",
+			blink:     ui.NewBlink(0.5),
 		},
+		timers:           timing.NewScheduler(),
+		grid:             collision.NewGrid[any](collisionCellSize),
+		collectableSpawn: timing.Every(game.Config.BaseCollectableSpawnInterval, nil),
+		explosions:       particles.NewEmitter(particles.Explosion(), 512),
+		sparks:           particles.NewEmitter(particles.Sparks(), 256),
 	}
 }
 
-// NewPauseMenuScene creates a new pause menu scene
-func NewPauseMenuScene(game *Game) *PauseMenuScene {
-	return &PauseMenuScene{
-		BaseScene: BaseScene{
-			Game:          game,
-			sceneName:     "Pause Menu",
-			blinkInterval: 0.5,
-			showOnBlink:   true,
-		},
-	}
-}
+// PlayingScene methods
 
-// NewGameOverScene creates a new game over scene
-func NewGameOverScene(game *Game) *GameOverScene {
-	return &GameOverScene{
-		BaseScene: BaseScene{
-			Game:          game,
-			sceneName:     "Game Over",
-			blinkInterval: 0.5,
-			showOnBlink:   true,
-		},
+// Enter subscribes to the gameplay events
+func (s *PlayingScene) Enter() {
+	s.BaseScene.Enter()
+	s.subscriptions = []events.Handle{
+		events.Subscribe(s.Events, s.onAlienKilled),
+		events.Subscribe(s.Events, s.onPlayerHit),
+		events.Subscribe(s.Events, s.onWaveCleared),
 	}
 }
 
-// MainMenuScene methods
-
-func (s *MainMenuScene) Draw(renderer *render.Renderer) {
-	width, height := s.Size()
-	startX := width / 10
-
-	const (
-		startOffset    = 1.0 / 6
-		controlsOffset = 2.0 / 8
-	)
-
-	_ = renderer.DrawText(fmt.Sprintf("%s - %s", s.Config.Title, s.sceneName), startX, int(float64(height)*titleOffset), render.ColorWhite)
-
-	if s.showOnBlink {
-		_ = renderer.DrawText("Press ENTER to start", startX, int(float64(height)*startOffset), render.ColorBrightMagenta)
+// Exit unsubscribes and drops the pending timers, so a wave announced before
+// the game ended doesn't start in the next one
+func (s *PlayingScene) Exit() {
+	s.BaseScene.Exit()
+	for _, subscription := range s.subscriptions {
+		subscription.Unsubscribe()
 	}
-
-	controlsY := int(float64(height) * controlsOffset)
-	_ = renderer.DrawText("Controls:", startX, controlsY, render.ColorBlue)
-	_ = renderer.DrawText("Arrow keys / WASD to move", startX, controlsY+lineSpacing, render.ColorWhite)
-	_ = renderer.DrawText("SPACE to shoot", startX, controlsY+2*lineSpacing, render.ColorWhite)
-	_ = renderer.DrawText("ESC to pause", startX, controlsY+3*lineSpacing, render.ColorWhite)
-	_ = renderer.DrawText("Q to pause/quit", startX, controlsY+4*lineSpacing, render.ColorWhite)
+	s.subscriptions = nil
+	s.timers.Clear()
+	s.nextWave = nil
 }
 
-func (s *MainMenuScene) HandleInput(input core.InputEvent) error {
-	switch input.Rune {
-	case core.KeyEnter:
-		s.Logger.Info("Starting new game")
-		s.CurrentLevel = s.Config.BaseLevel - s.Config.BaseLevelStep
-		s.Score = s.Config.BaseScore
-		s.Player.Health = s.Config.BasePlayerHealth
-		s.Player.MaxHealth = s.Config.BasePlayerHealth
-		s.Player.Lives = s.Config.BaseLives
-		s.Scenes.ChangeScene(PlayingSceneID)
-		return nil
-	case 'q', 'Q':
-		s.Scenes.ChangeScene(GameOverSceneID)
-		return core.ErrQuitGame
+func (s *PlayingScene) Update(dt float64) error {
+	if err := s.BaseScene.Update(dt); err != nil {
+		return err
+	}
+	s.timers.Update(dt)
+	for _, animation := range s.AlienAnimations {
+		animation.Update(dt)
 	}
 
-	return nil
-}
-
-// PlayingScene methods
-
-func (s *PlayingScene) Update(dt float64) {
-	s.BaseScene.Update(dt)
 	s.updateCollectables(dt)
 	s.updateAliens(dt)
 	s.updateProjectiles(dt)
+
 	s.updateCollisions()
+	s.explosions.Update(dt)
+	s.sparks.Update(dt)
 
 	s.murder()
-	s.updateGameState()
+	s.Events.Flush()
+	return s.updateGameState()
 }
 
 func (s *PlayingScene) Draw(renderer *render.Renderer) {
@@ -181,7 +139,7 @@ func (s *PlayingScene) Draw(renderer *render.Renderer) {
 	player := s.Player
 	playerChar, playerColor := s.getHealthInfo(player.Health, s.Player.MaxHealth)
 
-	_ = s.Renderer.DrawRect(
+	_ = renderer.DrawRect(
 		int(player.Position.X-player.Width/2),
 		int(player.Position.Y-player.Height/2),
 		int(player.Width),
@@ -190,13 +148,13 @@ func (s *PlayingScene) Draw(renderer *render.Renderer) {
 		playerColor,
 	)
 
-	s.drawObjOverlay(&player.Object, playerColor, OverlayOpts{})
+	s.drawObjOverlay(renderer, &player.Object, playerColor, OverlayOpts{})
 
 	// Draw collectibles
 	for _, collectable := range s.Collectables {
 		char, color := s.getCollectableInfo(collectable)
 
-		_ = s.Renderer.DrawRect(
+		_ = renderer.DrawRect(
 			int(collectable.Position.X-collectable.Width/2),
 			int(collectable.Position.Y-collectable.Height/2),
 			int(collectable.Width),
@@ -211,29 +169,31 @@ func (s *PlayingScene) Draw(renderer *render.Renderer) {
 		char, color := s.getAlienInfo(alien)
 
 		if s.Debug {
-			_ = s.Renderer.DrawText(fmt.Sprintf("%d", alien.AlienType),
+			_ = renderer.DrawText(fmt.Sprintf("%d", alien.AlienType),
 				int(alien.Position.X-alien.Width/2),
 				int(alien.Position.Y-alien.Height/2)-1,
 				color,
 			)
 		}
 
-		_ = s.Renderer.DrawRect(
-			int(alien.Position.X-alien.Width/2),
-			int(alien.Position.Y-alien.Height/2),
-			int(alien.Width),
-			int(alien.Height),
-			char,
-			color,
-		)
+		x, y := int(alien.Position.X-alien.Width/2), int(alien.Position.Y-alien.Height/2)
+		if animation, ok := s.AlienAnimations[alien.AlienType]; ok && alien.Health/alien.MaxHealth > 0.75 {
+			// Healthy aliens march, damaged ones fall back to shaded blocks
+			_ = animation.DrawColor(renderer, x, y, color)
+		} else {
+			_ = renderer.DrawRect(x, y, int(alien.Width), int(alien.Height), char, color)
+		}
 
-		s.drawObjOverlay(&alien.Object, color, OverlayOpts{})
+		s.drawObjOverlay(renderer, &alien.Object, color, OverlayOpts{})
 	}
 
+	s.explosions.Draw(renderer)
+	s.sparks.Draw(renderer)
+
 	// Draw projectiles
 	for _, projectile := range s.Projectiles {
 		char, color := s.getProjectileInfo(projectile)
-		_ = s.Renderer.DrawRect(
+		_ = renderer.DrawRect(
 			int(projectile.Position.X-projectile.Width/2),
 			int(projectile.Position.Y-projectile.Height/2),
 			int(projectile.Width),
@@ -242,14 +202,14 @@ func (s *PlayingScene) Draw(renderer *render.Renderer) {
 			color,
 		)
 
-		s.drawObjOverlay(&projectile.Object, render.ColorWhite, OverlayOpts{})
+		s.drawObjOverlay(renderer, &projectile.Object, renderer.Color(render.RoleOverlay), OverlayOpts{})
 	}
 
 	// Draw barriers
 	for _, barrier := range s.Barriers {
 		char, color := s.getBarrierInfo(barrier.Health, barrier.MaxHealth)
 
-		_ = s.Renderer.DrawRect(
+		_ = renderer.DrawRect(
 			int(barrier.Position.X-barrier.Width/2),
 			int(barrier.Position.Y-barrier.Height/2),
 			int(barrier.Width),
@@ -258,7 +218,7 @@ func (s *PlayingScene) Draw(renderer *render.Renderer) {
 			color,
 		)
 
-		s.drawObjOverlay(&barrier.Object, render.ColorWhite, OverlayOpts{Health: true})
+		s.drawObjOverlay(renderer, &barrier.Object, renderer.Color(render.RoleOverlay), OverlayOpts{Health: true})
 	}
 
 	// Draw score, level, lives...
@@ -267,28 +227,32 @@ func (s *PlayingScene) Draw(renderer *render.Renderer) {
 		args   []interface{}
 		color  render.Color
 	}{
-		{"Score: %d", []interface{}{s.Score}, render.ColorWhite},
-		{"Level: %d", []interface{}{s.CurrentLevel}, render.ColorWhite},
-		{"Enemies: %d", []interface{}{len(s.Aliens)}, render.ColorWhite},
+		{"Score: %d", []interface{}{s.Score}, s.Renderer.Color(render.RoleHUD)},
+		{"Level: %d", []interface{}{s.CurrentLevel}, s.Renderer.Color(render.RoleHUD)},
+		{"Enemies: %d", []interface{}{len(s.Aliens)}, s.Renderer.Color(render.RoleHUD)},
 		{"Health: %.2f", []interface{}{player.Health}, playerColor},
-		{"Lives: %d", []interface{}{player.Lives}, render.ColorWhite},
+		{"Lives: %d", []interface{}{player.Lives}, s.Renderer.Color(render.RoleHUD)},
 	}
 
 	for i, item := range info {
-		_ = s.Renderer.DrawText(fmt.Sprintf(item.format, item.args...), 1, i+1, item.color)
+		_ = renderer.DrawText(fmt.Sprintf(item.format, item.args...), 1, i+1, item.color)
 	}
 
+	if s.nextWave != nil && !s.nextWave.Done() {
+		width, _ := renderer.Size()
+		_ = renderer.DrawTextAligned(fmt.Sprintf("Level %d", s.CurrentLevel), 0, int(math.Round(s.waveBannerY)), width, render.AlignCenter, renderer.Color(render.RoleTitle))
+	}
 }
 
 type OverlayOpts struct {
 	Health bool
 }
 
-func (s *PlayingScene) drawObjOverlay(obj *Object, color render.Color, opts OverlayOpts) {
+func (s *PlayingScene) drawObjOverlay(renderer *render.Renderer, obj *Object, color render.Color, opts OverlayOpts) {
 	_, healthColor := s.getHealthInfo(obj.Health, obj.MaxHealth)
 
 	if s.Overlay || opts.Health {
-		_ = s.Renderer.DrawText(
+		_ = renderer.DrawText(
 			fmt.Sprintf("%.f", math.Round(obj.Health)),
 			int(obj.Position.X-obj.Width/2),
 			int(obj.Position.Y+obj.Height/2)-1,
@@ -307,7 +271,7 @@ func (s *PlayingScene) drawObjOverlay(obj *Object, color render.Color, opts Over
 		}
 
 		for i, info := range debugInfo {
-			_ = s.Renderer.DrawText(
+			_ = renderer.DrawText(
 				fmt.Sprintf(info.format, info.args...),
 				int(obj.Position.X+obj.Width/2)+1,
 				int(obj.Position.Y-obj.Height/2)-1+i,
@@ -324,7 +288,7 @@ func (s *PlayingScene) HandleInput(input core.InputEvent) error {
 	case '2', core.KeyF2:
 		s.Overlay = !s.Overlay
 	case core.KeyEscape, core.KeyTab, 'q', 'Q', 'p', 'P':
-		s.Scenes.ChangeScene(PauseMenuSceneID)
+		return s.Scenes.Push(PauseMenuSceneID, scenes.Frozen)
 	case 'w', 'W':
 		s.movePlayer(0, -1)
 	case 'a', 'A':
@@ -336,161 +300,12 @@ func (s *PlayingScene) HandleInput(input core.InputEvent) error {
 	case core.KeySpace:
 		s.shoot(&s.Player.Object)
 	case '_':
-		s.CurrentLevel -= s.Config.BaseLevelStep
+		s.SetLevel(s.CurrentLevel - s.Config.BaseLevelStep)
 		s.startWave()
 	case '+':
-		s.CurrentLevel += s.Config.BaseLevelStep
+		s.SetLevel(s.CurrentLevel + s.Config.BaseLevelStep)
 		s.startWave()
 	}
 
 	return nil
 }
-
-// PauseMenuScene methods
-
-func (s *PauseMenuScene) Draw(renderer *render.Renderer) {
-	const (
-		titleOffset    = 1.0 / 10
-		scoreOffset    = 1.0 / 6
-		controlsOffset = 1.0 / 4
-		lineSpacing    = 2
-	)
-
-	width, height := s.Size()
-	startX := width / 10
-
-	// Draw title
-	_ = renderer.DrawText(fmt.Sprintf("%s - %s", s.Config.Title, s.sceneName), startX, int(float64(height)*titleOffset), render.ColorWhite)
-
-	if s.showOnBlink {
-		_ = renderer.DrawText(
-			fmt.Sprintf("Score: %d | Level: %d | Enemies Remaining | %d", s.Score, s.CurrentLevel, len(s.Aliens)),
-			startX,
-			int(float64(height)*scoreOffset),
-			render.ColorMagenta,
-		)
-	}
-
-	// Draw controls
-	controlsY := int(float64(height) * controlsOffset)
-	_ = renderer.DrawText("Controls:", startX, controlsY, render.ColorBlue)
-	_ = renderer.DrawText("Press ESC to resume", startX, controlsY+lineSpacing, render.ColorWhite)
-	_ = renderer.DrawText("Press Q to quit", startX, controlsY+2*lineSpacing, render.ColorWhite)
-}
-
-func (s *PauseMenuScene) HandleInput(input core.InputEvent) error {
-	switch input.Rune {
-	case core.KeyEscape:
-		s.Scenes.ChangeScene(PlayingSceneID)
-	case 'q', 'Q':
-		s.Scenes.ChangeScene(GameOverSceneID)
-		return core.ErrQuitGame
-	}
-
-	return nil
-}
-
-// GameOverScene methods
-
-func (s *GameOverScene) Enter() {
-	s.BaseScene.Enter()
-	err := s.Leaderboard.Load(s.Config.BoardFile)
-	if err != nil {
-		s.Logger.Warn("Failed to load existing leaderboard. Creating a new one...", "path", s.Config.BoardFile, "err", err)
-		s.Leaderboard.Records = make([]leaderboard.Record, 0)
-	}
-}
-
-func (s *GameOverScene) GetDetails() string {
-	width, height := s.Size()
-	return fmt.Sprintf(
-		"%dW*%dH|L%d@%dBL|%.1fBH|%.1fBAH|(%.2fBD * %.1fBDM)|%dBS",
-		width, height,
-		s.CurrentLevel, s.Config.BaseLevel,
-		s.Config.BasePlayerHealth, s.Config.BaseAlienHealth,
-		s.Config.BaseDifficulty, s.Config.BaseDifficultyMultiplier, s.Config.BaseScore,
-	)
-}
-
-func (s *GameOverScene) Draw(renderer *render.Renderer) {
-	const (
-		scoreOffset       = 1.0 / 6
-		leaderboardOffset = 1.0 / 4
-		controlsOffset    = 3.0 / 4
-	)
-
-	width, height := s.Size()
-	startX := width / 10
-
-	// Draw title and game over message
-	_ = renderer.DrawText(fmt.Sprintf("%s - %s", s.Config.Title, s.sceneName), startX, int(float64(height)*titleOffset), render.ColorWhite)
-
-	if s.Score > 0 && !s.nameEntered {
-		// Draw name entry prompt and score
-		_ = renderer.DrawText(fmt.Sprintf("Score: %d", s.Score), startX, int(float64(height)*scoreOffset), render.ColorWhite)
-		_ = renderer.DrawText("Enter your name to save score (or press Q to skip):", startX, int(float64(height)*scoreOffset)+1, render.ColorWhite)
-		if s.showOnBlink {
-			_ = renderer.DrawText(s.name+"_", startX, int(float64(height)*scoreOffset)+2, render.ColorBrightMagenta)
-		} else {
-			_ = renderer.DrawText(s.name, startX, int(float64(height)*scoreOffset)+2, render.ColorBrightMagenta)
-		}
-	} else if s.Score > 0 && s.showOnBlink {
-		_ = renderer.DrawText(
-			fmt.Sprintf("%d | %s > %s", s.Score, s.name, s.GetDetails()),
-			startX,
-			int(float64(height)*scoreOffset),
-			render.ColorMagenta,
-		)
-	}
-
-	// Draw leaderboard
-	leaderboardY := int(float64(height) * leaderboardOffset)
-	_ = renderer.DrawText("Top Scores:", startX, leaderboardY, render.ColorBlue)
-	topScores := s.Leaderboard.TopScores(5)
-	for i, entry := range topScores {
-		_ = renderer.DrawText(fmt.Sprintf("%d | %s > %s", entry.Score, entry.Name, entry.Details), startX, leaderboardY+(i+1)*lineSpacing, render.ColorWhite)
-	}
-
-	// Draw controls
-	controlsY := int(float64(height) * controlsOffset)
-	_ = renderer.DrawText("Controls:", startX, controlsY, render.ColorBlue)
-	_ = renderer.DrawText("Press Q to quit the game", startX, controlsY+lineSpacing, render.ColorWhite)
-	_ = renderer.DrawText("Press ENTER to return to save/return to main menu", startX, controlsY+2*lineSpacing, render.ColorWhite)
-}
-
-func (s *GameOverScene) HandleInput(input core.InputEvent) error {
-	switch input.Rune {
-	case 'q', 'Q':
-		if !s.nameEntered && s.Score > 0 {
-			s.Logger.Info("Skipping leaderboard entry")
-			s.nameEntered = true
-		}
-		return core.ErrQuitGame
-	case core.KeyEnter:
-		if !s.nameEntered {
-			if len(s.name) > 0 && s.Score > 0 {
-				s.nameEntered = true
-				s.Logger.Info("Adding leaderboard entry...", "name", s.name, "score", s.Score)
-				s.Leaderboard.Add(s.name, s.Score, s.GetDetails())
-				err := s.Leaderboard.Save(s.Config.BoardFile)
-				if err != nil {
-					return err
-				}
-			}
-		} else {
-			s.Scenes.ChangeScene(MainMenuSceneID)
-		}
-	case core.KeyBackspace:
-		if !s.nameEntered && len(s.name) > 0 {
-			s.name = s.name[:len(s.name)-1]
-		}
-	default:
-		if !s.nameEntered {
-			// Only allow printable characters
-			if input.Rune >= 32 && input.Rune <= 126 && len(s.name) < 12 {
-				s.name += string(input.Rune)
-			}
-		}
-	}
-	return nil
-}
@@ -7,11 +7,29 @@ import (
 
 	"github.com/ojrac/opensimplex-go"
 
+	"github.com/kuhree/gg/internal/engine/events"
 	"github.com/kuhree/gg/internal/engine/objects"
+	"github.com/kuhree/gg/internal/engine/objects/collision"
 	"github.com/kuhree/gg/internal/engine/render"
+	"github.com/kuhree/gg/internal/engine/timing"
 	"github.com/kuhree/gg/internal/utils"
 )
 
+// Theme roles for the colors specific to Space Invaders, see render.Theme
+const (
+	roleShield       = "shield"
+	rolePowerUp      = "power_up"
+	roleRapidFire    = "rapid_fire"
+	roleMultiShot    = "multi_shot"
+	roleExtraLife    = "extra_life"
+	roleNuke         = "nuke"
+	roleFlash        = "flash"
+	roleFastAlien    = "fast_alien"
+	roleToughAlien   = "tough_alien"
+	roleBossAlien    = "boss_alien"
+	roleShooterAlien = "shooter_alien"
+)
+
 const (
 	PowerUpHealth CollectableType = iota
 	PowerUpRapidFire
@@ -21,6 +39,7 @@ const (
 )
 
 type CollectableAttributes struct {
+	Name        string
 	Type        CollectableType
 	SpawnChance float64
 	Duration    float64
@@ -28,26 +47,31 @@ type CollectableAttributes struct {
 
 var collectableTypes = map[CollectableType]CollectableAttributes{
 	PowerUpHealth: {
+		Name:        "health",
 		Type:        PowerUpHealth,
 		SpawnChance: 0.25,
 		Duration:    0,
 	},
 	PowerUpRapidFire: {
+		Name:        "rapid fire",
 		Type:        PowerUpRapidFire,
 		SpawnChance: 0.15,
 		Duration:    4.0,
 	},
 	PowerUpMultiShot: {
+		Name:        "multi shot",
 		Type:        PowerUpMultiShot,
 		SpawnChance: 0.20,
 		Duration:    6.0,
 	},
 	PowerUpExtraLife: {
+		Name:        "extra life",
 		Type:        PowerUpExtraLife,
 		SpawnChance: 0.05,
 		Duration:    0,
 	},
 	PowerUpNuke: {
+		Name:        "nuke",
 		Type:        PowerUpNuke,
 		SpawnChance: 0.10,
 		Duration:    0,
@@ -197,12 +221,8 @@ func (s *PlayingScene) updateCollectables(dt float64) {
 		collectable.Position.Y += s.Config.BaseCollectableSpeed * dt
 	}
 
-	s.collectableSpawnTimer += dt
-	if s.collectableSpawnTimer >= s.Config.BaseCollectableSpawnInterval {
-		s.collectableSpawnTimer = 0
-		if len(s.Collectables) < s.Config.BaseMaxCollectables {
-			s.spawnCollectables()
-		}
+	if s.collectableSpawn.Update(dt) && len(s.Collectables) < s.Config.BaseMaxCollectables {
+		s.spawnCollectables()
 	}
 }
 
@@ -260,14 +280,13 @@ func (s *PlayingScene) updateAliens(dt float64) {
 
 	// Shoott!
 	for _, alien := range s.Aliens {
-		alien.shootCooldown -= dt
-		if alien.shootCooldown <= 0 {
+		if alien.shootTimer.Update(dt) {
+			alien.shootTimer.Duration = alien.shootInterval
 			if rand.Float64() < alien.shootChance {
 				cooldownRandomFactor := rand.Float64() * s.Config.IntervalRandomFactor
-				alien.shootCooldown = alien.shootInterval * (1 + cooldownRandomFactor)
+				alien.shootTimer.Duration *= 1 + cooldownRandomFactor
 				s.shoot(&alien.Object)
 			}
-			alien.shootCooldown = alien.shootInterval
 		}
 	}
 }
@@ -287,109 +306,111 @@ func (s *PlayingScene) updateProjectiles(dt float64) {
 	}
 }
 
+// Collision layers
+const (
+	layerPlayer collision.Layer = 1 << iota
+	layerAlien
+	layerBarrier
+	layerCollectable
+	layerPlayerShot
+	layerAlienShot
+)
+
 // updateCollisions detects and handles collisions between game objects
-// collisions are something else
 func (s *PlayingScene) updateCollisions() {
 	player := s.Player
 
-	// player/collectable
-	for i := len(s.Collectables) - 1; i >= 0; i-- {
-		collectable := s.Collectables[i]
-		if s.collides(&player.Object, &collectable.Object) {
-			s.activateCollectable(collectable)
-			s.Collectables[i].Health = 0
-		}
+	s.grid.Clear()
+	s.grid.Insert(player, player.Bounds(), layerPlayer)
+	for _, alien := range s.Aliens {
+		s.grid.Insert(alien, alien.Bounds(), layerAlien)
 	}
-
-	for i := len(s.Aliens) - 1; i >= 0; i-- {
-		alien := s.Aliens[i]
-
-		// check alien/player collisions
-		if alien.Health > 0 && s.collides(&player.Object, &alien.Object) {
-			s.Player.Health -= alien.Health
-			s.Aliens[i].Health = 0 // kill it, don't wanna "bump" into it 1n times and die
+	for _, barrier := range s.Barriers {
+		s.grid.Insert(barrier, barrier.Bounds(), layerBarrier)
+	}
+	for _, collectable := range s.Collectables {
+		s.grid.Insert(collectable, collectable.Bounds(), layerCollectable)
+	}
+	for _, projectile := range s.Projectiles {
+		layer := layerAlienShot
+		if projectile.Source == &player.Object {
+			layer = layerPlayerShot
 		}
-
-		// check alien/barrier collisions
-		for j, barrier := range s.Barriers {
-			if barrier.Health > 0 && s.collides(&alien.Object, &barrier.Object) {
-				s.Barriers[j].Health -= alien.Health
-				s.Aliens[i].Health = 0
+		s.grid.Insert(projectile, projectile.Bounds(), layer)
+	}
+
+	// player/collectable and player/alien
+	for _, hit := range s.grid.Query(player.Bounds(), layerCollectable|layerAlien) {
+		switch target := hit.Item.(type) {
+		case *Collectable:
+			s.activateCollectable(target)
+			target.Health = 0
+		case *Alien:
+			if target.Health > 0 {
+				player.Health -= target.Health
+				events.Queue(s.Events, PlayerHit{Damage: target.Health, Source: &target.Object})
+				target.Health = 0 // kill it, don't wanna "bump" into it 1n times and die
 			}
 		}
 	}
 
-	// Check all projectile collisions
-	for i := len(s.Projectiles) - 1; i >= 0; i-- {
-		projectile := s.Projectiles[i]
-		isFromPlayer := projectile.Source == &player.Object
-
-		// projectile/player
-		if !isFromPlayer && projectile.Health > 0 && s.collides(&projectile.Object, &player.Object) {
-			s.Player.Health -= projectile.Health
-			s.Projectiles[i].Health = 0 // kill it w fire NOW
-		}
-
-		// projectile/alien
-		for j := len(s.Aliens) - 1; j >= 0; j-- {
-			alien := s.Aliens[j]
-			if isFromPlayer && alien.Health > 0 && s.collides(&projectile.Object, &alien.Object) {
-				damage := math.Min(projectile.Health, alien.Health)
-				s.Aliens[j].Health -= damage
-				s.Projectiles[i].Health -= damage
-
-				if s.Aliens[j].Health <= 0 {
-					s.increaseScore(int(alien.MaxHealth))
-				}
+	// alien/barrier
+	for _, alien := range s.Aliens {
+		for _, hit := range s.grid.Query(alien.Bounds(), layerBarrier) {
+			barrier := hit.Item.(*Barrier)
+			if barrier.Health > 0 {
+				barrier.Health -= alien.Health
+				alien.Health = 0
 			}
 		}
+	}
 
-		// projectile/barrier
-		for j := len(s.Barriers) - 1; j >= 0; j-- {
-			barrier := s.Barriers[j]
-			if !isFromPlayer && barrier.Health > 0 && s.collides(&projectile.Object, &barrier.Object) {
-				damage := math.Min(projectile.Health, barrier.Health)
-				s.Barriers[j].Health -= damage
-				s.Projectiles[i].Health -= damage
-			}
+	// Player shots hit aliens and alien shots, alien shots hit the player,
+	// barriers and player shots
+	for _, projectile := range s.Projectiles {
+		mask := layerPlayer | layerBarrier | layerPlayerShot
+		if projectile.Source == &player.Object {
+			mask = layerAlien | layerAlienShot
 		}
 
-		// projectile/projectile
-		for j := i + 1; j < len(s.Projectiles); j++ {
-			proj := s.Projectiles[j]
-			isFromPlayerInner := proj.Source == &player.Object
+		for _, hit := range s.grid.Query(projectile.Bounds(), mask) {
+			if projectile.Health <= 0 {
+				break
+			}
 
-			if isFromPlayer != isFromPlayerInner && proj.Health > 0 && s.collides(&projectile.Object, &proj.Object) {
-				damage := math.Min(projectile.Health, proj.Health)
-				s.Projectiles[i].Health -= damage
-				s.Projectiles[j].Health -= damage
+			switch target := hit.Item.(type) {
+			case *Player:
+				target.Health -= projectile.Health
+				events.Queue(s.Events, PlayerHit{Damage: projectile.Health, Source: &projectile.Object})
+				projectile.Health = 0 // kill it w fire NOW
+			case *Alien:
+				if s.damage(&projectile.Object, &target.Object) && target.Health <= 0 {
+					events.Queue(s.Events, AlienKilled{Alien: target})
+				}
+			case *Barrier:
+				s.damage(&projectile.Object, &target.Object)
+			case *Projectile:
+				s.damage(&projectile.Object, &target.Object)
 			}
 		}
 	}
 }
 
-// collides checks if two GameObjects are colliding
-func (s *PlayingScene) collides(obj1, obj2 *Object) bool {
-	// Calculate the edges of each object
-	left1 := obj1.Position.X - obj1.Width/2
-	right1 := obj1.Position.X + obj1.Width/2
-	top1 := obj1.Position.Y - obj1.Height/2
-	bottom1 := obj1.Position.Y + obj1.Height/2
-
-	left2 := obj2.Position.X - obj2.Width/2
-	right2 := obj2.Position.X + obj2.Width/2
-	top2 := obj2.Position.Y - obj2.Height/2
-	bottom2 := obj2.Position.Y + obj2.Height/2
-
-	// Check for overlap
-	if left1 < right2 && right1 > left2 && top1 < bottom2 && bottom1 > top2 {
-		return true
+// damage trades health between a projectile and what it hit, reporting
+// whether the target was still alive to be hit
+func (s *PlayingScene) damage(projectile, target *Object) bool {
+	if target.Health <= 0 {
+		return false
 	}
 
-	return false
+	damage := math.Min(projectile.Health, target.Health)
+	target.Health -= damage
+	projectile.Health -= damage
+	return true
 }
 
 func (s *PlayingScene) activateCollectable(c *Collectable) {
+	events.Emit(s.Events, events.PowerUpCollected{Name: collectableTypes[c.CollectableType].Name, Duration: c.Duration})
 	switch c.CollectableType {
 	case PowerUpHealth:
 		s.Player.Health += s.Config.BasePlayerHealth
@@ -400,6 +421,8 @@ func (s *PlayingScene) activateCollectable(c *Collectable) {
 	case PowerUpExtraLife:
 		s.Player.Lives++
 	case PowerUpNuke:
+		s.sparks.Burst(c.Position.X, c.Position.Y, 60)
+		s.Effects.Add(render.Flash{Color: s.Renderer.ColorAs(roleFlash, render.RoleOverlay, render.ColorBrightWhite)}, render.Envelope{Hold: 0.05, Release: 0.35})
 		s.destroyAllVisibleAliens()
 	}
 }
@@ -414,6 +437,7 @@ func (s *PlayingScene) murder() {
 	for i := len(s.Aliens) - 1; i >= 0; i-- {
 		alien := s.Aliens[i]
 		if alien.Health <= 0 {
+			s.explosions.Burst(alien.Position.X, alien.Position.Y, int(alien.Width*alien.Height)*2)
 			s.Aliens = append(s.Aliens[:i], s.Aliens[i+1:]...)
 		}
 	}
@@ -421,6 +445,7 @@ func (s *PlayingScene) murder() {
 	for i := len(s.Barriers) - 1; i >= 0; i-- {
 		barrier := s.Barriers[i]
 		if barrier.Health <= 0 {
+			s.sparks.Burst(barrier.Position.X, barrier.Position.Y, int(barrier.Width))
 			s.Barriers = append(s.Barriers[:i], s.Barriers[i+1:]...)
 		}
 	}
@@ -441,29 +466,56 @@ func (s *PlayingScene) murder() {
 }
 
 // updateGameState determines if the game should end
-func (s *PlayingScene) updateGameState() {
+func (s *PlayingScene) updateGameState() error {
 	if s.Player.Health <= 0 {
 		s.Logger.Info("Player is out of health. Losing life...")
 		s.Player.Health = 0
 		s.Player.Lives--
+		events.Emit(s.Events, events.LifeLost{Lives: s.Player.Lives})
 
 		if s.Player.Lives <= 0 {
-			s.Logger.Info("Player out of lives. Game over...")
-			s.Scenes.ChangeScene(GameOverSceneID)
-			return
+			s.GameOver("Out of lives")
+			return s.Scenes.ChangeSceneWith(GameOverSceneID, gameOverTransition)
 		}
 
 		s.setupLevelPlayer(s.difficulty())
-		return
+		return nil
 	}
 
-	if len(s.Aliens) <= 0 {
-		s.Logger.Info("Level cleared! Advancing...", "newLevel", s.CurrentLevel+s.Config.BaseLevelStep)
-		s.CurrentLevel += s.Config.BaseLevelStep
-
-		s.startWave()
-		return
+	if len(s.Aliens) <= 0 && (s.nextWave == nil || s.nextWave.Done()) {
+		events.Emit(s.Events, WaveCleared{Level: s.CurrentLevel})
 	}
+	return nil
+}
+
+// onAlienKilled scores the alien's full health
+func (s *PlayingScene) onAlienKilled(e AlienKilled) {
+	s.increaseScore(int(e.Alien.MaxHealth))
+}
+
+// onPlayerHit shakes the screen
+func (s *PlayingScene) onPlayerHit(e PlayerHit) {
+	s.Logger.Debug("Player hit", "damage", e.Damage, "health", s.Player.Health)
+	s.Effects.Add(render.Shake{Intensity: 2}, render.Envelope{Release: 0.3})
+}
+
+// onWaveCleared advances to the next level and announces its wave
+func (s *PlayingScene) onWaveCleared(e WaveCleared) {
+	s.Logger.Info("Level cleared! Advancing...", "newLevel", e.Level+s.Config.BaseLevelStep)
+	s.SetLevel(e.Level + s.Config.BaseLevelStep)
+	s.announceWave()
+}
+
+// announceWave slides a banner for the new level in, starts the wave and
+// slides the banner back out
+func (s *PlayingScene) announceWave() {
+	_, height := s.Size()
+	s.waveBannerY = -1
+	s.nextWave = s.timers.Sequence().
+		Tween(&s.waveBannerY, float64(height/3), 0.6, timing.EaseOutBack).
+		Wait(0.8).
+		Do(s.startWave).
+		Tween(&s.waveBannerY, -1, 0.4, timing.EaseIn)
 }
 
 // startWave configures the game state for the current level
@@ -486,7 +538,7 @@ func (s *PlayingScene) startWave() {
 
 func (s *PlayingScene) increaseScore(delta int) int {
 	s.Logger.Info("Increasing score!", "score", s.Score, "delta", delta, "newScore", s.Score+delta)
-	s.Score += delta
+	s.SetScore(s.Score + delta)
 	return s.Score
 }
 
@@ -567,7 +619,7 @@ func (s *PlayingScene) generateAliens(count int, difficultyMultiplier float64) [
 			},
 			AlienType:     alienType,
 			shootInterval: adjustedShootInterval,
-			shootCooldown: rand.Float64() * adjustedShootInterval * s.Config.CooldownMultiplier,
+			shootTimer:    timing.Every(rand.Float64()*adjustedShootInterval*s.Config.CooldownMultiplier, nil),
 			shootChance:   attributes.ShootChance * difficultyMultiplier,
 		}
 		aliens = append(aliens, alien)
@@ -711,24 +763,24 @@ func (s *PlayingScene) setupLevelBarriers(difficultyMultiplier float64) {
 	}
 }
 func (s *PlayingScene) getCollectableInfo(col *Collectable) (rune, render.Color) {
-	char := 'C'                 // Default character
-	color := render.ColorYellow // Default color
+	char := 'C' // Default character
+	color := s.Renderer.ColorAs(rolePowerUp, render.RoleAccent, render.ColorYellow)
 	switch col.CollectableType {
 	case PowerUpHealth:
 		char = 'S'
-		color = render.ColorBlue
+		color = s.Renderer.ColorAs(roleShield, render.RoleSuccess, render.ColorBlue)
 	case PowerUpRapidFire:
 		char = 'R'
-		color = render.ColorGreen
+		color = s.Renderer.ColorAs(roleRapidFire, render.RoleAccent, render.ColorGreen)
 	case PowerUpMultiShot:
 		char = 'M'
-		color = render.ColorMagenta
+		color = s.Renderer.ColorAs(roleMultiShot, render.RoleAccent, render.ColorMagenta)
 	case PowerUpExtraLife:
 		char = 'L'
-		color = render.ColorCyan
+		color = s.Renderer.ColorAs(roleExtraLife, render.RoleSuccess, render.ColorCyan)
 	case PowerUpNuke:
 		char = 'B'
-		color = render.ColorRed
+		color = s.Renderer.ColorAs(roleNuke, render.RoleDanger, render.ColorRed)
 	}
 
 	return char, color
@@ -741,11 +793,11 @@ func (s *PlayingScene) getAlienInfo(alien *Alien) (rune, render.Color) {
 		Char  rune
 		Color render.Color
 	}{
-		FastAlien:    {'~', render.ColorCyan},
-		ToughAlien:   {'#', render.ColorGreen},
-		BossAlien:    {'T', render.ColorRed},
-		ShooterAlien: {'+', render.ColorBlue},
-		BasicAlien:   {render.FullBlock, render.ColorWhite},
+		FastAlien:    {'~', s.Renderer.ColorAs(roleFastAlien, render.RoleEnemy, render.ColorCyan)},
+		ToughAlien:   {'#', s.Renderer.ColorAs(roleToughAlien, render.RoleWarning, render.ColorGreen)},
+		BossAlien:    {'T', s.Renderer.ColorAs(roleBossAlien, render.RoleDanger, render.ColorRed)},
+		ShooterAlien: {'+', s.Renderer.ColorAs(roleShooterAlien, render.RoleWarning, render.ColorBlue)},
+		BasicAlien:   {render.FullBlock, s.Renderer.Color(render.RoleEnemy)},
 	}
 
 	conf, ok := alienConfigs[alien.AlienType]
@@ -779,9 +831,9 @@ func (s *PlayingScene) getBarrierInfo(health float64, maxHealth float64) (rune,
 	ratio := health / float64(maxHealth)
 	switch {
 	case ratio > 1.0:
-		return render.FullBlock, render.ColorGreen
+		return render.FullBlock, s.Renderer.Color(render.RoleSuccess)
 	case ratio >= 0.90:
-		return render.FullBlock, render.ColorGreen
+		return render.FullBlock, s.Renderer.Color(render.RoleSuccess)
 	default:
 		return s.getHealthInfo(health, maxHealth)
 	}
@@ -791,19 +843,19 @@ func (s *PlayingScene) getHealthInfo(health float64, maxHealth float64) (rune, r
 	ratio := health / float64(maxHealth)
 	switch {
 	case ratio > 1.0:
-		return render.FullBlock, render.ColorBlue
+		return render.FullBlock, s.Renderer.ColorAs(roleShield, render.RoleSuccess, render.ColorBlue)
 	case ratio == 1.0:
-		return render.FullBlock, render.ColorWhite
+		return render.FullBlock, s.Renderer.ColorOr(render.RolePlayer, render.ColorWhite)
 	case ratio >= 1.0:
-		return render.DarkShade, render.ColorWhite
+		return render.DarkShade, s.Renderer.ColorOr(render.RolePlayer, render.ColorWhite)
 	case ratio >= 0.75:
-		return render.LightShade, render.ColorYellow
+		return render.LightShade, s.Renderer.Color(render.RoleWarning)
 	case ratio >= 0.5:
-		return render.MediumShade, render.ColorYellow
+		return render.MediumShade, s.Renderer.Color(render.RoleWarning)
 	case ratio >= 0.25:
-		return render.LightShade, render.ColorRed
+		return render.LightShade, s.Renderer.Color(render.RoleDanger)
 	default:
-		return render.FullBlock, render.ColorWhite
+		return render.FullBlock, s.Renderer.ColorOr(render.RolePlayer, render.ColorWhite)
 	}
 }
 
@@ -813,6 +865,9 @@ func (s *PlayingScene) getProjectileInfo(proj *Projectile) (rune, render.Color)
 
 	isFromPlayer := proj.Source == &s.Player.Object
 
+	// The colors rank the projectile's power rather than mean anything a
+	// theme would recolor, so they stay fixed like a gradient and collapse
+	// to one color on semantic themes
 	powerRatio := proj.Health / s.Player.Health
 	switch {
 	case powerRatio <= 1:
@@ -833,15 +888,16 @@ func (s *PlayingScene) getProjectileInfo(proj *Projectile) (rune, render.Color)
 	default:
 		char, color = render.FullBlock, render.ColorRed // Extremely powerful power
 	}
+	color = s.Renderer.RampColor(render.RoleAccent, color)
 
 	ratio := proj.Health / float64(proj.MaxHealth)
 	switch {
 	case ratio <= 0.25:
-		char, color = render.LightShade, render.ColorRed
+		char, color = render.LightShade, s.Renderer.Color(render.RoleDanger)
 	case ratio <= 0.5:
-		char, color = render.MediumShade, render.ColorYellow
+		char, color = render.MediumShade, s.Renderer.Color(render.RoleWarning)
 	case ratio <= 0.75:
-		char, color = render.LightShade, render.ColorYellow
+		char, color = render.LightShade, s.Renderer.Color(render.RoleWarning)
 	}
 
 	return char, color
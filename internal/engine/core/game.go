@@ -2,6 +2,8 @@ package core
 
 import (
 	"errors"
+
+	"github.com/kuhree/gg/internal/engine/events"
 )
 
 // Game interface defines the methods that all games must implement
@@ -14,6 +16,18 @@ type Game interface {
 	Cleanup()
 }
 
+// Screenshotter is implemented by games that can save their current frame.
+// The game loop calls it when KeyScreenshot is pressed.
+type Screenshotter interface {
+	Screenshot() ([]string, error)
+}
+
+// Publisher is implemented by games that publish gameplay events, so
+// engine-level systems can subscribe to them
+type Publisher interface {
+	EventBus() *events.Bus
+}
+
 // Common errors
 var (
 	ErrQuitGame = errors.New("quit game")
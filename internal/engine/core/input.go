@@ -17,6 +17,9 @@ const (
 	KeySpace     = rune(' ')
 	KeyBackspace = rune(127)
 
+	KeyScreenshot = rune(19) // Ctrl+S
+	KeyInterrupt  = rune(3)  // Ctrl+C
+
 	KeyQ = rune(113)
 	KeyE = rune(101)
 	KeyW = rune(119)
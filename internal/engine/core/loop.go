@@ -1,6 +1,7 @@
 package core
 
 import (
+	"bufio"
 	"fmt"
 	"log/slog"
 	"os"
@@ -8,7 +9,6 @@ import (
 	"syscall"
 	"time"
 
-	"github.com/kuhree/gg/internal/engine/render"
 	"github.com/kuhree/gg/internal/utils"
 	"golang.org/x/term"
 )
@@ -20,6 +20,7 @@ type GameLoop struct {
 	logger    *slog.Logger
 	running   bool
 	keyEvents chan InputEvent
+	elapsed   float64
 
 	resize  chan os.Signal
 	signals chan os.Signal
@@ -45,30 +46,30 @@ func (gl *GameLoop) Run(targetTime, targetFps float64) error {
 	if err != nil {
 		return err
 	}
+	defer gl.game.Cleanup()
 
-	// Set terminal into raw mode to capture input
-	fd := int(os.Stdin.Fd())
-	oldState, err := term.MakeRaw(fd)
+	// Set the terminal up for the game, and put it back however Run returns
+	terminal, err := setupTerminal()
 	if err != nil {
-		panic(err)
+		return err
 	}
-	defer term.Restore(fd, oldState)
-	defer render.ShowCursor()
+	defer terminal.restore()
 
-	gl.term = term.NewTerminal(os.Stdin, "")
+	gl.term = terminal.term
 	gl.updateTerminalSize(gl.term)
-	gl.term.SetBracketedPasteMode(true)
 
 	// Capture signals to gracefully exit
-	signal.Notify(gl.signals, syscall.SIGINT, syscall.SIGTERM)
+	signal.Notify(gl.signals, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP, syscall.SIGQUIT)
 	signal.Notify(gl.resize, syscall.SIGWINCH)
+	defer signal.Stop(gl.signals)
+	defer signal.Stop(gl.resize)
 
 	// Start listener(s) in a separate goroutine
 	go func() {
+		// Decode UTF-8 so non-ASCII keys arrive as a single rune
+		reader := bufio.NewReader(os.Stdin)
 		for gl.running {
-			var buf [1]byte
-
-			n, err := os.Stdin.Read(buf[:])
+			char, n, err := reader.ReadRune()
 			if err != nil {
 				if err.Error() == "EOF" {
 					gl.logger.Error("EOF received, exiting input loop.")
@@ -82,9 +83,9 @@ func (gl *GameLoop) Run(targetTime, targetFps float64) error {
 			}
 
 			if n > 0 {
-				gl.logger.Debug("Key pressed", "key", fmt.Sprintf("%c", buf), "rune", rune(buf[0]))
+				gl.logger.Debug("Key pressed", "key", fmt.Sprintf("%c", char), "rune", char)
 				gl.keyEvents <- InputEvent{
-					Rune: rune(buf[0]),
+					Rune: char,
 				}
 			}
 		}
@@ -97,6 +98,7 @@ func (gl *GameLoop) Run(targetTime, targetFps float64) error {
 		deltaTime := currentTime.Sub(lastTime).Seconds()
 		deltaTime *= targetTime // Speedup/slowdown the game
 		lastTime = currentTime
+		gl.elapsed += deltaTime
 
 		// Handle events (non-blocking)
 		select {
@@ -110,6 +112,12 @@ func (gl *GameLoop) Run(targetTime, targetFps float64) error {
 				gl.logger.Error("Unable to access keyboard channel. Exiting", "err", err)
 				gl.Stop()
 				continue
+			} else if keyEvent.Rune == KeyInterrupt {
+				// Raw mode delivers Ctrl+C as a key instead of SIGINT
+				gl.logger.Info("Interrupted. Exiting...")
+				gl.Stop()
+			} else if keyEvent.Rune == KeyScreenshot {
+				gl.screenshot()
 			} else if err := gl.game.HandleInput(keyEvent); err != nil {
 				gl.Stop()
 				if err != ErrQuitGame {
@@ -141,14 +149,35 @@ func (gl *GameLoop) Run(targetTime, targetFps float64) error {
 		}
 	}
 
-	gl.game.Cleanup()
 	return nil
 }
 
+// Elapsed returns the game time in seconds since the loop started, scaled by
+// the target time
+func (gl *GameLoop) Elapsed() float64 {
+	return gl.elapsed
+}
+
 // Stop stops the game loop
 func (gl *GameLoop) Stop() {
 	gl.running = false
 }
 
+// screenshot saves the current frame if the game supports it
+func (gl *GameLoop) screenshot() {
+	shooter, ok := gl.game.(Screenshotter)
+	if !ok {
+		gl.logger.Warn("Game does not support screenshots")
+		return
+	}
+
+	paths, err := shooter.Screenshot()
+	if err != nil {
+		gl.logger.Error("Failed to save screenshot", "err", err)
+		return
+	}
+	gl.logger.Info("Screenshot saved", "paths", paths)
+}
+
 func (gl *GameLoop) updateTerminalSize(term *term.Terminal) {
 }
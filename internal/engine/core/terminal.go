@@ -0,0 +1,49 @@
+package core
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/kuhree/gg/internal/engine/render"
+	"golang.org/x/term"
+)
+
+// terminal remembers how the terminal was set up before the game loop took
+// it over, so it can be put back exactly as it was
+type terminal struct {
+	fd    int
+	state *term.State
+	term  *term.Terminal
+	once  sync.Once
+}
+
+// setupTerminal switches stdin to raw mode to capture input, enables
+// bracketed paste and moves drawing to the alternate screen
+func setupTerminal() (*terminal, error) {
+	fd := int(os.Stdin.Fd())
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to put terminal into raw mode: %w", err)
+	}
+
+	t := &terminal{
+		fd:    fd,
+		state: state,
+		term:  term.NewTerminal(os.Stdin, ""),
+	}
+	t.term.SetBracketedPasteMode(true)
+	render.EnterScreen()
+
+	return t, nil
+}
+
+// restore leaves the alternate screen, resets colors, wrapping and the cursor
+// and takes stdin out of raw mode. It is safe to call more than once.
+func (t *terminal) restore() {
+	t.once.Do(func() {
+		t.term.SetBracketedPasteMode(false)
+		render.LeaveScreen()
+		_ = term.Restore(t.fd, t.state)
+	})
+}
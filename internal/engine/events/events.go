@@ -1,38 +1,135 @@
+// Package events implements a typed event bus. Events are plain structs and
+// listeners subscribe to an event by its type, e.g.
+//
+//	events.Subscribe(bus, func(e AlienKilled) { ... })
+//	events.Emit(bus, AlienKilled{Alien: alien})
+//
+// Emit dispatches right away, Queue holds the event until the next Flush so
+// it's handled at a safe point in the frame.
 package events
 
 import (
-	"fmt"
+	"reflect"
+	"slices"
 )
 
-type GameEvent int
+// Bus dispatches events to the listeners subscribed to their type
+type Bus struct {
+	listeners map[reflect.Type][]*listener
+	queue     []func()
+	nextID    uint64
+}
+
+// NewBus creates a bus without listeners
+func NewBus() *Bus {
+	return &Bus{
+		listeners: make(map[reflect.Type][]*listener),
+	}
+}
+
+// listener is a subscribed callback
+type listener struct {
+	id       uint64
+	priority int
+	once     bool
+	removed  bool
+	call     func(any)
+}
 
-type EventCallback[T any] func(T)
+// Option configures a listener when subscribing
+type Option func(*listener)
 
-type EventManager[T any] struct {
-	listeners map[GameEvent][]EventCallback[T]
+// Once removes the listener after the first event it receives
+func Once() Option {
+	return func(l *listener) { l.once = true }
 }
 
-func NewEventManager[T any]() *EventManager[T] {
-	return &EventManager[T]{
-		listeners: make(map[GameEvent][]EventCallback[T]),
+// Priority orders listeners, higher priorities are called first. Listeners
+// with the same priority are called in the order they subscribed.
+func Priority(priority int) Option {
+	return func(l *listener) { l.priority = priority }
+}
+
+// Handle identifies a subscription, use it to unsubscribe
+type Handle struct {
+	bus *Bus
+	typ reflect.Type
+	id  uint64
+}
+
+// Unsubscribe removes the listener. It's safe to call more than once and
+// while the event is being dispatched.
+func (h Handle) Unsubscribe() {
+	if h.bus != nil {
+		h.bus.remove(h.typ, h.id)
 	}
 }
 
-func (es *EventManager[T]) Subscribe(event GameEvent, callback EventCallback[T]) {
-	es.listeners[event] = append(es.listeners[event], callback)
+// Subscribe calls fn for every event of type E emitted on the bus
+func Subscribe[E any](bus *Bus, fn func(E), opts ...Option) Handle {
+	bus.nextID++
+	l := &listener{
+		id:   bus.nextID,
+		call: func(event any) { fn(event.(E)) },
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+
+	typ := reflect.TypeFor[E]()
+	listeners := bus.listeners[typ]
+	i := len(listeners)
+	for i > 0 && listeners[i-1].priority < l.priority {
+		i--
+	}
+	bus.listeners[typ] = slices.Insert(listeners, i, l)
+
+	return Handle{bus: bus, typ: typ, id: l.id}
 }
 
-func (es *EventManager[T]) UnsubscribeAll(event GameEvent) error {
-	if _, ok := es.listeners[event]; !ok {
-		return fmt.Errorf("event not found")
+// Emit calls the listeners for the event right away
+func Emit[E any](bus *Bus, event E) {
+	typ := reflect.TypeFor[E]()
+
+	// Listeners may subscribe or unsubscribe while handling the event
+	for _, l := range slices.Clone(bus.listeners[typ]) {
+		if l.removed {
+			continue
+		}
+		if l.once {
+			bus.remove(typ, l.id)
+		}
+		l.call(event)
 	}
+}
 
-	delete(es.listeners, event)
-	return nil
+// Queue holds the event until the next Flush
+func Queue[E any](bus *Bus, event E) {
+	bus.queue = append(bus.queue, func() { Emit(bus, event) })
 }
 
-func (es *EventManager[T]) Emit(event GameEvent, game T) {
-	for _, callback := range es.listeners[event] {
-		callback(game)
+// Flush dispatches the queued events in the order they were queued,
+// including events queued by their listeners
+func (b *Bus) Flush() {
+	for len(b.queue) > 0 {
+		emit := b.queue[0]
+		b.queue = b.queue[1:]
+		emit()
 	}
 }
+
+// Pending returns the number of queued events
+func (b *Bus) Pending() int {
+	return len(b.queue)
+}
+
+// remove drops a listener, marking it so an ongoing dispatch skips it
+func (b *Bus) remove(typ reflect.Type, id uint64) {
+	b.listeners[typ] = slices.DeleteFunc(b.listeners[typ], func(l *listener) bool {
+		if l.id == id {
+			l.removed = true
+			return true
+		}
+		return false
+	})
+}
@@ -0,0 +1,97 @@
+package events
+
+import (
+	"slices"
+	"testing"
+)
+
+type ping struct{ n int }
+
+type pong struct{}
+
+func TestOnce(t *testing.T) {
+	bus := NewBus()
+	var got []int
+	Subscribe(bus, func(e ping) { got = append(got, e.n) }, Once())
+	Subscribe(bus, func(pong) { t.Error("pong listener called for a ping") })
+
+	Emit(bus, ping{1})
+	Emit(bus, ping{2})
+	if !slices.Equal(got, []int{1}) {
+		t.Errorf("Once listener got %v, want [1]", got)
+	}
+}
+
+func TestPriority(t *testing.T) {
+	bus := NewBus()
+	var got []string
+	Subscribe(bus, func(ping) { got = append(got, "a") })
+	Subscribe(bus, func(ping) { got = append(got, "high") }, Priority(10))
+	Subscribe(bus, func(ping) { got = append(got, "b") })
+	Subscribe(bus, func(ping) { got = append(got, "low") }, Priority(-1))
+	Subscribe(bus, func(ping) { got = append(got, "high2") }, Priority(10))
+
+	Emit(bus, ping{})
+	if want := []string{"high", "high2", "a", "b", "low"}; !slices.Equal(got, want) {
+		t.Errorf("called %v, want %v", got, want)
+	}
+}
+
+func TestUnsubscribeDuringDispatch(t *testing.T) {
+	bus := NewBus()
+	var got []string
+	var second Handle
+	first := Subscribe(bus, func(ping) {
+		got = append(got, "first")
+		second.Unsubscribe()
+	})
+	second = Subscribe(bus, func(ping) { got = append(got, "second") })
+	var self Handle
+	self = Subscribe(bus, func(ping) {
+		got = append(got, "self")
+		self.Unsubscribe()
+		self.Unsubscribe() // twice is harmless
+		// Listeners added during dispatch wait for the next event
+		Subscribe(bus, func(ping) { got = append(got, "late") })
+	})
+
+	Emit(bus, ping{})
+	if want := []string{"first", "self"}; !slices.Equal(got, want) {
+		t.Errorf("first emit called %v, want %v", got, want)
+	}
+
+	got = nil
+	first.Unsubscribe()
+	Emit(bus, ping{})
+	if want := []string{"late"}; !slices.Equal(got, want) {
+		t.Errorf("second emit called %v, want %v", got, want)
+	}
+
+	// The zero Handle does nothing
+	Handle{}.Unsubscribe()
+}
+
+func TestQueueFlush(t *testing.T) {
+	bus := NewBus()
+	var got []int
+	Subscribe(bus, func(e ping) {
+		got = append(got, e.n)
+		if e.n == 1 {
+			Queue(bus, ping{3})
+		}
+	})
+
+	Queue(bus, ping{1})
+	Queue(bus, ping{2})
+	if len(got) != 0 || bus.Pending() != 2 {
+		t.Fatalf("got %v with %d pending before Flush, want nothing and 2", got, bus.Pending())
+	}
+
+	bus.Flush()
+	if want := []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("Flush dispatched %v, want %v", got, want)
+	}
+	if bus.Pending() != 0 {
+		t.Errorf("Pending after Flush = %d, want 0", bus.Pending())
+	}
+}
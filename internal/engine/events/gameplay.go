@@ -0,0 +1,92 @@
+package events
+
+// Gameplay events every game publishes, so engine-level systems like stats
+// and session logs can follow a game without knowing its code
+
+// GameStarted is published when a new game starts, with the score and
+// level it starts from
+type GameStarted struct {
+	Game  string
+	Score int
+	Level int
+}
+
+// ScoreChanged is published whenever the score changes
+type ScoreChanged struct {
+	Score int
+	Delta int
+}
+
+// LevelChanged is published whenever the level changes
+type LevelChanged struct {
+	Level int
+}
+
+// LifeLost is published when the player loses a life
+type LifeLost struct {
+	// Lives left
+	Lives int
+}
+
+// GameOver is published when a game ends
+type GameOver struct {
+	Reason string
+	Score  int
+	Level  int
+}
+
+// PowerUpCollected is published when the player picks up a power-up
+type PowerUpCollected struct {
+	Name string
+	// Duration in seconds, 0 for instant power-ups
+	Duration float64
+}
+
+// Stats is the score and level of a game in progress. Games embed it so
+// every change publishes the matching event on Events.
+type Stats struct {
+	Events       *Bus
+	Score        int
+	CurrentLevel int
+}
+
+// NewStats creates stats publishing on a new bus
+func NewStats() Stats {
+	return Stats{Events: NewBus()}
+}
+
+// EventBus returns the bus the game publishes its gameplay events on
+func (s *Stats) EventBus() *Bus {
+	return s.Events
+}
+
+// Start resets the score and level for a new game and publishes GameStarted.
+// The reset itself isn't published as a change.
+func (s *Stats) Start(game string, score, level int) {
+	s.Score, s.CurrentLevel = score, level
+	Emit(s.Events, GameStarted{Game: game, Score: score, Level: level})
+}
+
+// SetScore updates the score and publishes the change
+func (s *Stats) SetScore(score int) {
+	delta := score - s.Score
+	if delta == 0 {
+		return
+	}
+	s.Score = score
+	Emit(s.Events, ScoreChanged{Score: score, Delta: delta})
+}
+
+// SetLevel updates the level and publishes the change
+func (s *Stats) SetLevel(level int) {
+	if level == s.CurrentLevel {
+		return
+	}
+	s.CurrentLevel = level
+	Emit(s.Events, LevelChanged{Level: level})
+}
+
+// GameOver publishes the game's outcome
+func (s *Stats) GameOver(reason string) {
+	Emit(s.Events, GameOver{Reason: reason, Score: s.Score, Level: s.CurrentLevel})
+}
@@ -0,0 +1,53 @@
+package events
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestStats(t *testing.T) {
+	stats := NewStats()
+	var published []any
+	Subscribe(stats.EventBus(), func(e ScoreChanged) { published = append(published, e) })
+	Subscribe(stats.EventBus(), func(e LevelChanged) { published = append(published, e) })
+	Subscribe(stats.EventBus(), func(e GameOver) { published = append(published, e) })
+
+	stats.SetScore(10)
+	stats.SetScore(10) // unchanged, not published
+	stats.SetScore(4)
+	stats.SetLevel(0) // unchanged, not published
+	stats.SetLevel(2)
+	stats.GameOver("Out of lives")
+
+	want := []any{
+		ScoreChanged{Score: 10, Delta: 10},
+		ScoreChanged{Score: 4, Delta: -6},
+		LevelChanged{Level: 2},
+		GameOver{Reason: "Out of lives", Score: 4, Level: 2},
+	}
+	if !slices.Equal(published, want) {
+		t.Errorf("published %v, want %v", published, want)
+	}
+	if stats.Score != 4 || stats.CurrentLevel != 2 {
+		t.Errorf("Score, CurrentLevel = %d, %d, want 4, 2", stats.Score, stats.CurrentLevel)
+	}
+}
+
+func TestStatsStart(t *testing.T) {
+	stats := NewStats()
+	stats.SetScore(30)
+	stats.SetLevel(3)
+
+	var published []any
+	Subscribe(stats.EventBus(), func(e GameStarted) { published = append(published, e) })
+	Subscribe(stats.EventBus(), func(e ScoreChanged) { published = append(published, e) })
+	Subscribe(stats.EventBus(), func(e LevelChanged) { published = append(published, e) })
+
+	stats.Start("Invaders", 100, 1)
+	if want := []any{GameStarted{Game: "Invaders", Score: 100, Level: 1}}; !slices.Equal(published, want) {
+		t.Errorf("published %v, want %v", published, want)
+	}
+	if stats.Score != 100 || stats.CurrentLevel != 1 {
+		t.Errorf("Score, CurrentLevel = %d, %d, want 100, 1", stats.Score, stats.CurrentLevel)
+	}
+}
@@ -0,0 +1,212 @@
+// Package golden compares rendered frames against checked-in text fixtures.
+//
+// A test draws into an in-memory renderer and asserts the frame:
+//
+//	frame := golden.Draw(t, 80, 24, scene.Draw)
+//	golden.Assert(t, "main_menu", frame, golden.WithColors())
+//
+// Fixtures live in testdata/<name>.golden, one line per row. The optional
+// color layer lives in testdata/<name>.colors.golden with one hex digit per
+// cell (the Color index, 0-f, or ? outside the palette). Run
+// `go test ./... -update` to rewrite them.
+package golden
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kuhree/gg/internal/engine/render"
+)
+
+var update = flag.Bool("update", false, "rewrite golden fixtures with the current output")
+
+// Dir is where fixtures are read from and written to
+var Dir = "testdata"
+
+// maxDiffCells limits how many differing cells are listed in a failure
+const maxDiffCells = 20
+
+// Draw renders one frame with draw into a width x height renderer backed by
+// memory, using the default theme so fixtures don't depend on --theme
+func Draw(t testing.TB, width, height int, draw func(*render.Renderer)) *render.MemoryBackend {
+	t.Helper()
+
+	previous := render.CurrentTheme()
+	render.UseTheme(render.DefaultTheme)
+	defer render.UseTheme(previous)
+
+	renderer := render.NewRenderer(width, height, render.DefaultPalette)
+	frame := render.NewMemoryBackend()
+	renderer.SetBackend(frame)
+
+	draw(renderer)
+	if err := renderer.Render(); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	return frame
+}
+
+// Frame is a grid of cells, such as a render.MemoryBackend or render.Renderer
+type Frame interface {
+	Size() (int, int)
+	Cell(x, y int) (rune, render.Color)
+}
+
+type options struct {
+	colors bool
+}
+
+// Option changes what Assert compares
+type Option func(*options)
+
+// WithColors also compares the color of every cell
+func WithColors() Option {
+	return func(o *options) {
+		o.colors = true
+	}
+}
+
+// Assert compares frame with the fixture called name, failing t with a
+// cell-level diff on mismatch. With -update the fixture is rewritten instead.
+func Assert(t testing.TB, name string, frame Frame, opts ...Option) {
+	t.Helper()
+
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	check(t, name+".golden", Text(frame), "text")
+	if o.colors {
+		check(t, name+".colors.golden", Colors(frame), "colors")
+	}
+}
+
+func check(t testing.TB, filename string, got []string, layer string) {
+	t.Helper()
+
+	path := filepath.Join(Dir, filename)
+	if *update {
+		if err := os.MkdirAll(Dir, 0755); err != nil {
+			t.Fatalf("golden: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(strings.Join(got, "\n")+"\n"), 0644); err != nil {
+			t.Fatalf("golden: %v", err)
+		}
+		return
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("golden: %v (run with -update to create it)", err)
+	}
+	want := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+
+	if diff := Diff(want, got); diff != "" {
+		t.Errorf("golden: %s layer of %s differs (run with -update to accept):\n%s", layer, path, diff)
+	}
+}
+
+// Text returns the frame's characters, one string per row
+func Text(frame Frame) []string {
+	width, height := frame.Size()
+	rows := make([]string, height)
+	for y := range rows {
+		var sb strings.Builder
+		for x := 0; x < width; x++ {
+			char, _ := frame.Cell(x, y)
+			if char != 0 { // skip the right half of wide characters
+				sb.WriteRune(char)
+			}
+		}
+		rows[y] = sb.String()
+	}
+	return rows
+}
+
+// Colors returns the frame's colors as hex digits, one string per row.
+// Colors outside the 16 palette entries, like render.ColorInherit, are
+// written as '?' to keep one character per cell.
+func Colors(frame Frame) []string {
+	width, height := frame.Size()
+	rows := make([]string, height)
+	for y := range rows {
+		var sb strings.Builder
+		for x := 0; x < width; x++ {
+			_, color := frame.Cell(x, y)
+			sb.WriteByte(colorDigit(color))
+		}
+		rows[y] = sb.String()
+	}
+	return rows
+}
+
+// colorDigit returns the hex digit for a palette color, or '?' outside the palette
+func colorDigit(color render.Color) byte {
+	const digits = "0123456789abcdef"
+	if color < 0 || int(color) >= len(digits) {
+		return '?'
+	}
+	return digits[color]
+}
+
+// Diff describes the differences between two frames row by row, marking
+// differing columns with ^ and listing the first differing cells. It returns
+// an empty string if they match.
+func Diff(want, got []string) string {
+	var sb strings.Builder
+	var cells []string
+
+	rows := max(len(want), len(got))
+	for y := 0; y < rows; y++ {
+		var w, g []rune
+		if y < len(want) {
+			w = []rune(want[y])
+		}
+		if y < len(got) {
+			g = []rune(got[y])
+		}
+		if string(w) == string(g) {
+			continue
+		}
+
+		marks := make([]rune, max(len(w), len(g)))
+		for x := range marks {
+			wc, gc := cellAt(w, x), cellAt(g, x)
+			if wc == gc {
+				marks[x] = ' '
+				continue
+			}
+			marks[x] = '^'
+			cells = append(cells, fmt.Sprintf("(%d,%d): want %q, got %q", x, y, wc, gc))
+		}
+
+		fmt.Fprintf(&sb, "row %d:\n  want |%s|\n  got  |%s|\n        %s\n", y, string(w), string(g), strings.TrimRight(string(marks), " "))
+	}
+
+	if len(cells) == 0 {
+		return ""
+	}
+
+	sb.WriteString("cells:\n")
+	for i, cell := range cells {
+		if i == maxDiffCells {
+			fmt.Fprintf(&sb, "  ... and %d more\n", len(cells)-maxDiffCells)
+			break
+		}
+		sb.WriteString("  " + cell + "\n")
+	}
+	return sb.String()
+}
+
+// cellAt returns the rune at x, or 0 past the end of the row
+func cellAt(row []rune, x int) rune {
+	if x < len(row) {
+		return row[x]
+	}
+	return 0
+}
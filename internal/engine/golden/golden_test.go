@@ -0,0 +1,73 @@
+package golden
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/kuhree/gg/internal/engine/render"
+)
+
+// grid is a frame built by hand
+type grid struct {
+	chars  [][]rune
+	colors [][]render.Color
+}
+
+func (g grid) Size() (int, int) {
+	return len(g.chars[0]), len(g.chars)
+}
+
+func (g grid) Cell(x, y int) (rune, render.Color) {
+	return g.chars[y][x], g.colors[y][x]
+}
+
+func TestText(t *testing.T) {
+	frame := Draw(t, 6, 2, func(r *render.Renderer) {
+		_ = r.DrawText("hi 你", 0, 0, render.ColorWhite)
+		_ = r.DrawText("ok", 4, 1, render.ColorWhite)
+	})
+
+	want := []string{"hi 你 ", "    ok"}
+	if got := Text(frame); !slices.Equal(got, want) {
+		t.Errorf("Text = %q, want %q", got, want)
+	}
+}
+
+func TestColors(t *testing.T) {
+	frame := grid{
+		chars: [][]rune{[]rune("abcd")},
+		colors: [][]render.Color{{
+			render.ColorBlack, render.ColorBrightWhite, render.ColorInherit, render.Color(16),
+		}},
+	}
+
+	want := []string{"0f??"}
+	if got := Colors(frame); !slices.Equal(got, want) {
+		t.Errorf("Colors = %q, want %q", got, want)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	if diff := Diff([]string{"abc", "def"}, []string{"abc", "def"}); diff != "" {
+		t.Errorf("Diff of equal frames = %q, want empty", diff)
+	}
+
+	diff := Diff([]string{"abc", "def"}, []string{"abc", "dxf", "g"})
+	for _, want := range []string{
+		"row 1:\n  want |def|\n  got  |dxf|\n         ^\n",
+		"(1,1): want 'e', got 'x'",
+		"(0,2): want '\\x00', got 'g'",
+	} {
+		if !strings.Contains(diff, want) {
+			t.Errorf("Diff = %q, want it to contain %q", diff, want)
+		}
+	}
+}
+
+func TestDiffLimitsCells(t *testing.T) {
+	diff := Diff([]string{strings.Repeat("a", 30)}, []string{strings.Repeat("b", 30)})
+	if !strings.Contains(diff, "... and 10 more") {
+		t.Errorf("Diff = %q, want it to truncate the cell list", diff)
+	}
+}
@@ -0,0 +1,156 @@
+package menus
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/kuhree/gg/internal/engine/core"
+	"github.com/kuhree/gg/internal/engine/leaderboard"
+	"github.com/kuhree/gg/internal/engine/render"
+	"github.com/kuhree/gg/internal/engine/scenes"
+	"github.com/kuhree/gg/internal/engine/ui"
+)
+
+// GameOverConfig configures a GameOver
+type GameOverConfig struct {
+	Leaderboard *leaderboard.Board
+	// BoardFile is where the leaderboard is loaded from and saved to
+	BoardFile string
+	// LeaderboardSize is how many top scores are shown
+	LeaderboardSize int
+	MaxNameLength   int
+
+	// Score returns the final score, only positive scores can be saved
+	Score func() int
+	// Details returns the notes saved with a score, e.g. the settings played with
+	Details func() string
+
+	// Next is the scene ENTER returns to, entered with Transition
+	Next       scenes.SceneID
+	Transition scenes.Transition
+}
+
+// GameOver shows the final score, asks for a name to save it under and
+// lists the top scores
+type GameOver struct {
+	base
+	config      GameOverConfig
+	name        *ui.TextInput
+	nameEntered bool
+	controls    *ui.List
+}
+
+// NewGameOver creates a game over scene
+func NewGameOver(ctx Context, config GameOverConfig) *GameOver {
+	s := &GameOver{
+		base:   newBase(ctx, "Game Over"),
+		config: config,
+		name:   ui.NewTextInput(config.MaxNameLength),
+		controls: &ui.List{
+			Heading: "Controls:",
+			Items: []string{
+				"Press Q to quit the game",
+				"Press ENTER to return to save/return to main menu",
+			},
+			Spacing: lineSpacing,
+		},
+	}
+
+	s.name.Blink = s.blink
+	s.name.OnSubmit = s.submit
+	return s
+}
+
+// Enter loads the leaderboard and clears the name from the last game
+func (s *GameOver) Enter() {
+	s.base.Enter()
+	s.nameEntered = false
+	s.name.SetValue("")
+
+	err := s.config.Leaderboard.Load(s.config.BoardFile)
+	if err != nil {
+		s.Logger.Warn("Failed to load existing leaderboard. Creating a new one...", "path", s.config.BoardFile, "err", err)
+		s.config.Leaderboard.Records = make([]leaderboard.Record, 0)
+	}
+}
+
+func (s *GameOver) score() int {
+	if s.config.Score == nil {
+		return 0
+	}
+	return s.config.Score()
+}
+
+func (s *GameOver) details() string {
+	if s.config.Details == nil {
+		return ""
+	}
+	return s.config.Details()
+}
+
+func (s *GameOver) Draw(renderer *render.Renderer) {
+	const (
+		scoreOffset       = 1.0 / 6
+		leaderboardOffset = 1.0 / 4
+		controlsOffset    = 3.0 / 4
+	)
+
+	area := s.area(renderer)
+	s.heading().Draw(renderer, ui.From(area, titleOffset))
+
+	score := s.score()
+	if score > 0 && !s.nameEntered {
+		// Draw name entry prompt and score
+		rows := ui.Rows(ui.From(area, scoreOffset), 0, 1, 1, 1)
+		(&ui.Label{Text: fmt.Sprintf("Score: %d", score)}).Draw(renderer, rows[0])
+		(&ui.Label{Text: "Enter your name to save score (or press Q to skip):"}).Draw(renderer, rows[1])
+		s.name.Draw(renderer, rows[2])
+	} else if score > 0 {
+		saved := &ui.Label{
+			Text:  fmt.Sprintf("%d | %s > %s", score, s.name.Value(), s.details()),
+			Role:  render.RoleStatus,
+			Blink: s.blink,
+		}
+		saved.Draw(renderer, ui.From(area, scoreOffset))
+	}
+
+	// Draw leaderboard
+	scores := &ui.Table{Headers: []string{"Score", "Name", "Details"}}
+	for _, entry := range s.config.Leaderboard.TopScores(s.config.LeaderboardSize) {
+		scores.Rows = append(scores.Rows, []string{strconv.Itoa(entry.Score), entry.Name, entry.Details})
+	}
+	scores.Draw(renderer, ui.From(area, leaderboardOffset))
+
+	s.controls.Draw(renderer, ui.From(area, controlsOffset))
+}
+
+func (s *GameOver) HandleInput(input core.InputEvent) error {
+	switch {
+	case input.Rune == 'q' || input.Rune == 'Q':
+		if !s.nameEntered && s.score() > 0 {
+			s.Logger.Info("Skipping leaderboard entry")
+			s.nameEntered = true
+		}
+		return core.ErrQuitGame
+	case s.nameEntered || s.score() <= 0:
+		if input.Rune == core.KeyEnter {
+			return s.Scenes.ChangeSceneWith(s.config.Next, s.config.Transition)
+		}
+	default:
+		_, err := s.name.HandleInput(input)
+		return err
+	}
+	return nil
+}
+
+// submit saves the score under the entered name
+func (s *GameOver) submit(name string) error {
+	if name == "" {
+		return nil
+	}
+
+	s.nameEntered = true
+	s.Logger.Info("Adding leaderboard entry...", "name", name, "score", s.score())
+	s.config.Leaderboard.Add(name, s.score(), s.details())
+	return s.config.Leaderboard.Save(s.config.BoardFile)
+}
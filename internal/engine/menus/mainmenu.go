@@ -0,0 +1,81 @@
+package menus
+
+import (
+	"strings"
+
+	"github.com/kuhree/gg/internal/engine/core"
+	"github.com/kuhree/gg/internal/engine/render"
+	"github.com/kuhree/gg/internal/engine/scenes"
+	"github.com/kuhree/gg/internal/engine/ui"
+)
+
+// MainMenuConfig configures a MainMenu
+type MainMenuConfig struct {
+	// Banner is the title art text, defaults to the upper-cased title
+	Banner string
+	// Controls lists the game's controls below the menu
+	Controls []string
+	// Start prepares a new game, it may be nil
+	Start func() error
+	// Next is the scene a new game starts in, entered with Transition
+	Next       scenes.SceneID
+	Transition scenes.Transition
+}
+
+// MainMenu shows the title art, a Start/Quit menu and the controls
+type MainMenu struct {
+	base
+	config   MainMenuConfig
+	title    *ui.Banner
+	menu     *ui.Menu
+	controls *ui.List
+}
+
+// NewMainMenu creates a main menu scene
+func NewMainMenu(ctx Context, config MainMenuConfig) *MainMenu {
+	banner := config.Banner
+	if banner == "" {
+		banner = strings.ToUpper(ctx.Title)
+	}
+
+	s := &MainMenu{
+		base:     newBase(ctx, "Main Menu"),
+		config:   config,
+		title:    &ui.Banner{Text: banner},
+		controls: &ui.List{Heading: "Controls:", Items: config.Controls, Spacing: lineSpacing},
+	}
+
+	s.menu = ui.NewMenu(
+		ui.MenuItem{Label: "Start game", Action: s.start},
+		ui.MenuItem{Label: "Quit (Q)", Keys: []rune{'q', 'Q'}, Action: s.quit},
+	)
+	s.menu.Blink = s.blink
+	return s
+}
+
+func (s *MainMenu) Draw(renderer *render.Renderer) {
+	area := s.area(renderer)
+	rows := ui.Rows(ui.From(area, titleOffset), 1, s.title.Height(area.Width), s.menu.Height(), 0)
+	s.title.Draw(renderer, rows[0])
+	s.menu.Draw(renderer, rows[1])
+	s.controls.Draw(renderer, rows[2])
+}
+
+func (s *MainMenu) HandleInput(input core.InputEvent) error {
+	_, err := s.menu.HandleInput(input)
+	return err
+}
+
+func (s *MainMenu) start() error {
+	s.Logger.Info("Starting new game")
+	if s.config.Start != nil {
+		if err := s.config.Start(); err != nil {
+			return err
+		}
+	}
+	return s.Scenes.ChangeSceneWith(s.config.Next, s.config.Transition)
+}
+
+func (s *MainMenu) quit() error {
+	return core.ErrQuitGame
+}
@@ -0,0 +1,80 @@
+// Package menus provides the standard scenes most games share: a main menu,
+// a pause overlay and a game over screen with name entry and a leaderboard.
+// Games configure them with their title, controls and callbacks and add them
+// to their scenes.Manager like any other scene.
+package menus
+
+import (
+	"log/slog"
+
+	"github.com/kuhree/gg/internal/engine/core"
+	"github.com/kuhree/gg/internal/engine/render"
+	"github.com/kuhree/gg/internal/engine/scenes"
+	"github.com/kuhree/gg/internal/engine/ui"
+)
+
+// Layout shared by the menu scenes
+const (
+	titleOffset = 1.0 / 10
+	lineSpacing = 2
+)
+
+// Context is the part of a game the standard scenes work with
+type Context struct {
+	Title  string
+	Scenes *scenes.Manager
+	Logger *slog.Logger
+}
+
+// base provides logging and a blink timer for the standard scenes
+type base struct {
+	Context
+	name  string
+	blink *ui.Blink
+}
+
+func newBase(ctx Context, name string) base {
+	return base{Context: ctx, name: name, blink: ui.NewBlink(0.5)}
+}
+
+// Enter logs when a scene is entered
+func (s *base) Enter() {
+	s.Logger.Info("Entering scene", "scene", s.name)
+}
+
+// Exit logs when a scene is exited
+func (s *base) Exit() {
+	s.Logger.Info("Exiting scene", "scene", s.name)
+}
+
+// Pause logs when an overlay is pushed on top of a scene
+func (s *base) Pause() {
+	s.Logger.Info("Pausing scene", "scene", s.name)
+}
+
+// Resume logs when the overlay on top of a scene is popped
+func (s *base) Resume() {
+	s.Logger.Info("Resuming scene", "scene", s.name)
+}
+
+// Update advances the blink timer
+func (s *base) Update(dt float64) error {
+	s.blink.Update(dt)
+	return nil
+}
+
+// HandleInput is a no-op for scenes that don't handle input
+func (s *base) HandleInput(input core.InputEvent) error {
+	return nil
+}
+
+// area is where the scenes lay out their widgets, inset from the screen edges
+func (s *base) area(renderer *render.Renderer) render.Rect {
+	width, _ := renderer.Size()
+	return ui.Inset(ui.Screen(renderer), width/10, 0)
+}
+
+// heading is the "Title - Scene" line at the top of the pause and game over screens
+func (s *base) heading() *ui.Label {
+	return &ui.Label{Text: s.Title + " - " + s.name, Role: render.RoleTitle}
+}
@@ -0,0 +1,72 @@
+package menus
+
+import (
+	"github.com/kuhree/gg/internal/engine/core"
+	"github.com/kuhree/gg/internal/engine/render"
+	"github.com/kuhree/gg/internal/engine/ui"
+)
+
+// PauseMenuConfig configures a PauseMenu
+type PauseMenuConfig struct {
+	// Status returns the line shown above the menu, e.g. the score
+	Status func() string
+}
+
+// PauseMenu is an overlay that dims the frozen game below it and offers to
+// resume or quit. Push it with scenes.Frozen, resuming pops it.
+type PauseMenu struct {
+	base
+	config PauseMenuConfig
+	menu   *ui.Menu
+}
+
+// NewPauseMenu creates a pause menu scene
+func NewPauseMenu(ctx Context, config PauseMenuConfig) *PauseMenu {
+	s := &PauseMenu{
+		base:   newBase(ctx, "Pause Menu"),
+		config: config,
+	}
+
+	s.menu = ui.NewMenu(
+		ui.MenuItem{Label: "Resume (ESC)", Keys: []rune{core.KeyEscape}, Action: s.resume},
+		ui.MenuItem{Label: "Quit (Q)", Keys: []rune{'q', 'Q'}, Action: s.quit},
+	)
+	s.menu.Spacing = lineSpacing
+	return s
+}
+
+func (s *PauseMenu) Draw(renderer *render.Renderer) {
+	const (
+		statusOffset = 1.0 / 6
+		menuOffset   = 1.0 / 4
+	)
+
+	// Dim the frozen gameplay drawn below the menu and box the menu in
+	render.Dim{}.Apply(renderer, 1)
+
+	area := s.area(renderer)
+	top := ui.From(area, titleOffset).Y
+	bottom := ui.From(area, menuOffset).Y + s.menu.Height()
+	ui.DrawBox(renderer, render.Rect{X: area.X - 2, Y: top - 1, Width: area.Width + 4, Height: bottom - top + 2}, renderer.Color(render.RoleHeading))
+	s.heading().Draw(renderer, ui.From(area, titleOffset))
+
+	if s.config.Status != nil {
+		status := &ui.Label{Text: s.config.Status(), Role: render.RoleStatus, Blink: s.blink}
+		status.Draw(renderer, ui.From(area, statusOffset))
+	}
+	s.menu.Draw(renderer, ui.From(area, menuOffset))
+}
+
+func (s *PauseMenu) HandleInput(input core.InputEvent) error {
+	_, err := s.menu.HandleInput(input)
+	return err
+}
+
+func (s *PauseMenu) resume() error {
+	s.Scenes.Pop()
+	return nil
+}
+
+func (s *PauseMenu) quit() error {
+	return core.ErrQuitGame
+}
@@ -0,0 +1,108 @@
+package collision
+
+import (
+	"math"
+
+	"github.com/kuhree/gg/internal/engine/objects"
+)
+
+// Contact describes how two shapes overlap. Moving the first shape by
+// Normal * Penetration separates it from the second.
+type Contact struct {
+	Normal      objects.Vector2D
+	Penetration float64
+}
+
+// Overlaps reports whether two shapes overlap
+func Overlaps(a, b Shape) bool {
+	_, ok := Collide(a, b)
+	return ok
+}
+
+// Collide tests two shapes, returning the contact if they overlap. Shapes
+// that only touch don't collide.
+func Collide(a, b Shape) (Contact, bool) {
+	switch a := a.(type) {
+	case Circle:
+		if b, ok := b.(Circle); ok {
+			return circles(a, b)
+		}
+		return circleBox(a, b.Bounds())
+	default:
+		if b, ok := b.(Circle); ok {
+			contact, ok := circleBox(b, a.Bounds())
+			contact.Normal = contact.Normal.Neg()
+			return contact, ok
+		}
+		return boxes(a.Bounds(), b.Bounds())
+	}
+}
+
+// boxes collides two boxes, separating them along the axis they overlap least on
+func boxes(a, b AABB) (Contact, bool) {
+	overlapX := math.Min(a.Max.X, b.Max.X) - math.Max(a.Min.X, b.Min.X)
+	overlapY := math.Min(a.Max.Y, b.Max.Y) - math.Max(a.Min.Y, b.Min.Y)
+	if overlapX <= 0 || overlapY <= 0 {
+		return Contact{}, false
+	}
+
+	d := a.Center().Sub(b.Center())
+	if overlapX < overlapY {
+		return Contact{Normal: objects.Vector2D{X: sign(d.X)}, Penetration: overlapX}, true
+	}
+	return Contact{Normal: objects.Vector2D{Y: sign(d.Y)}, Penetration: overlapY}, true
+}
+
+// circles collides two circles
+func circles(a, b Circle) (Contact, bool) {
+	d := a.Center.Sub(b.Center)
+	distance := d.Length()
+	penetration := a.Radius + b.Radius - distance
+	if penetration <= 0 {
+		return Contact{}, false
+	}
+
+	normal := d.Normalize()
+	if distance == 0 {
+		normal = objects.Up
+	}
+	return Contact{Normal: normal, Penetration: penetration}, true
+}
+
+// circleBox collides a circle with a box
+func circleBox(c Circle, b AABB) (Contact, bool) {
+	if b.Contains(c.Center) {
+		// The center is inside, push out through the nearest side
+		sides := []struct {
+			normal   objects.Vector2D
+			distance float64
+		}{
+			{objects.Left, c.Center.X - b.Min.X},
+			{objects.Right, b.Max.X - c.Center.X},
+			{objects.Up, c.Center.Y - b.Min.Y},
+			{objects.Down, b.Max.Y - c.Center.Y},
+		}
+		nearest := sides[0]
+		for _, side := range sides[1:] {
+			if side.distance < nearest.distance {
+				nearest = side
+			}
+		}
+		return Contact{Normal: nearest.normal, Penetration: nearest.distance + c.Radius}, true
+	}
+
+	d := c.Center.Sub(b.closest(c.Center))
+	distance := d.Length()
+	if distance >= c.Radius {
+		return Contact{}, false
+	}
+	return Contact{Normal: d.Normalize(), Penetration: c.Radius - distance}, true
+}
+
+// sign returns 1 for positive values and zero, -1 for negative ones
+func sign(v float64) float64 {
+	if v < 0 {
+		return -1
+	}
+	return 1
+}
@@ -0,0 +1,81 @@
+package collision
+
+import (
+	"math"
+	"testing"
+
+	"github.com/kuhree/gg/internal/engine/objects"
+)
+
+func TestCollide(t *testing.T) {
+	box := Rect(0, 0, 10, 10)
+	circle := func(x, y, radius float64) Circle {
+		return Circle{Center: objects.Vector2D{X: x, Y: y}, Radius: radius}
+	}
+	tests := []struct {
+		name        string
+		a, b        Shape
+		ok          bool
+		normal      objects.Vector2D
+		penetration float64
+	}{
+		{"boxes overlapping on x", Rect(0, 0, 4, 4), Rect(3, 1, 4, 4), true, objects.Left, 1},
+		{"boxes overlapping on y", Rect(0, 0, 4, 4), Rect(0, 3, 4, 4), true, objects.Up, 1},
+		{"boxes from below", Rect(0, 3, 4, 4), Rect(0, 0, 4, 4), true, objects.Down, 1},
+		{"boxes touching", Rect(0, 0, 2, 2), Rect(2, 0, 2, 2), false, objects.Vector2D{}, 0},
+		{"boxes apart", Rect(0, 0, 2, 2), Rect(5, 5, 2, 2), false, objects.Vector2D{}, 0},
+
+		{"circles overlapping", circle(0, 0, 2), circle(3, 0, 2), true, objects.Left, 1},
+		{"circles on the same center", circle(1, 1, 2), circle(1, 1, 2), true, objects.Up, 4},
+		{"circles touching", circle(0, 0, 1), circle(2, 0, 1), false, objects.Vector2D{}, 0},
+
+		{"circle beside a box", circle(12, 5, 3), box, true, objects.Right, 1},
+		{"circle at a corner", circle(13, 14, 6), box, true, objects.Vector2D{X: 0.6, Y: 0.8}, 1},
+		{"circle apart from a box", circle(15, 5, 3), box, false, objects.Vector2D{}, 0},
+		{"box beside a circle", box, circle(12, 5, 3), true, objects.Left, 1},
+
+		{"circle inside near the right", circle(9.5, 5, 1), box, true, objects.Right, 1.5},
+		{"circle inside near the left", circle(2, 5, 1), box, true, objects.Left, 3},
+		{"circle inside near the top", circle(5, 0.5, 1), box, true, objects.Up, 1.5},
+		{"circle inside near the bottom", circle(4, 8, 1), box, true, objects.Down, 3},
+		{"box around a circle", box, circle(9.5, 5, 1), true, objects.Left, 1.5},
+	}
+	for _, tt := range tests {
+		contact, ok := Collide(tt.a, tt.b)
+		if ok != tt.ok {
+			t.Errorf("%s: collided = %v, want %v", tt.name, ok, tt.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if !contact.Normal.ApproxEqual(tt.normal, objects.Epsilon) || math.Abs(contact.Penetration-tt.penetration) > objects.Epsilon {
+			t.Errorf("%s: contact = %v by %v, want %v by %v", tt.name, contact.Normal, contact.Penetration, tt.normal, tt.penetration)
+		}
+		if ok != Overlaps(tt.a, tt.b) {
+			t.Errorf("%s: Overlaps disagrees with Collide", tt.name)
+		}
+	}
+}
+
+func TestCollideSeparates(t *testing.T) {
+	// Moving the first shape along the contact leaves them just touching
+	box := Rect(0, 0, 10, 10)
+	for _, c := range []Circle{
+		{Center: objects.Vector2D{X: 9.5, Y: 5}, Radius: 1},
+		{Center: objects.Vector2D{X: 5, Y: 5}, Radius: 1},
+		{Center: objects.Vector2D{X: 11, Y: 5}, Radius: 2},
+	} {
+		contact, ok := Collide(c, box)
+		if !ok {
+			t.Fatalf("%v doesn't collide", c)
+		}
+		moved := Circle{Center: c.Center.Add(contact.Normal.Scale(contact.Penetration + 1e-9)), Radius: c.Radius}
+		if Overlaps(moved, box) {
+			t.Errorf("%v still overlaps after moving it by %v", c, contact)
+		}
+		if !Overlaps(Circle{Center: c.Center.Add(contact.Normal.Scale(contact.Penetration - 0.01)), Radius: c.Radius}, box) {
+			t.Errorf("%v pushed further than needed by %v", c, contact)
+		}
+	}
+}
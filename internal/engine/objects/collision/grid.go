@@ -0,0 +1,119 @@
+package collision
+
+import (
+	"math"
+	"slices"
+)
+
+// Layer is a set of collision layers, one per bit. Shapes are inserted on
+// layers and queries only see the layers in their mask.
+type Layer uint32
+
+// AllLayers matches every layer
+const AllLayers Layer = math.MaxUint32
+
+// Hit is a shape found by a query
+type Hit[T any] struct {
+	Item    T
+	Layer   Layer
+	Contact Contact
+}
+
+// cell is the coordinate of a grid cell
+type cell struct{ x, y int }
+
+// entry is an inserted shape
+type entry[T any] struct {
+	item  T
+	shape Shape
+	layer Layer
+}
+
+// Grid is a spatial hash broad phase. Shapes are bucketed by the cells their
+// bounds cover, so a query only tests the shapes near it. Rebuild it every
+// frame with Clear and Insert.
+type Grid[T any] struct {
+	cellSize float64
+	cells    map[cell][]int
+	entries  []entry[T]
+
+	// seen stamps entries already tested by the current query
+	seen  []int
+	query int
+}
+
+// NewGrid creates a grid with square cells of the given size, ideally about
+// the size of the larger shapes
+func NewGrid[T any](cellSize float64) *Grid[T] {
+	return &Grid[T]{
+		cellSize: cellSize,
+		cells:    make(map[cell][]int),
+	}
+}
+
+// Clear removes every shape
+func (g *Grid[T]) Clear() {
+	clear(g.cells)
+	g.entries = g.entries[:0]
+}
+
+// Insert adds a shape on a layer, with the item queries return for it
+func (g *Grid[T]) Insert(item T, shape Shape, layer Layer) {
+	index := len(g.entries)
+	g.entries = append(g.entries, entry[T]{item: item, shape: shape, layer: layer})
+	g.each(shape.Bounds(), func(c cell) {
+		g.cells[c] = append(g.cells[c], index)
+	})
+}
+
+// Len returns the number of shapes in the grid
+func (g *Grid[T]) Len() int {
+	return len(g.entries)
+}
+
+// Query returns the shapes on the mask's layers that collide with shape, in
+// the order they were inserted
+func (g *Grid[T]) Query(shape Shape, mask Layer) []Hit[T] {
+	if len(g.seen) < len(g.entries) {
+		g.seen = make([]int, len(g.entries))
+		g.query = 0
+	}
+	g.query++
+
+	var candidates []int
+	g.each(shape.Bounds(), func(c cell) {
+		for _, index := range g.cells[c] {
+			if g.seen[index] == g.query || g.entries[index].layer&mask == 0 {
+				continue
+			}
+			g.seen[index] = g.query
+			candidates = append(candidates, index)
+		}
+	})
+
+	slices.Sort(candidates)
+	var hits []Hit[T]
+	for _, index := range candidates {
+		e := g.entries[index]
+		if contact, ok := Collide(shape, e.shape); ok {
+			hits = append(hits, Hit[T]{Item: e.item, Layer: e.layer, Contact: contact})
+		}
+	}
+	return hits
+}
+
+// each calls fn for every cell the bounds cover
+func (g *Grid[T]) each(bounds AABB, fn func(cell)) {
+	minX, minY := g.cellOf(bounds.Min.X), g.cellOf(bounds.Min.Y)
+	maxX, maxY := g.cellOf(bounds.Max.X), g.cellOf(bounds.Max.Y)
+	for y := minY; y <= maxY; y++ {
+		for x := minX; x <= maxX; x++ {
+			fn(cell{x, y})
+		}
+	}
+}
+
+// cellOf returns the cell coordinate of a position along one axis
+func (g *Grid[T]) cellOf(v float64) int {
+	return int(math.Floor(v / g.cellSize))
+}
@@ -0,0 +1,53 @@
+package collision
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/kuhree/gg/internal/engine/objects"
+)
+
+func TestGridQuery(t *testing.T) {
+	grid := NewGrid[string](4)
+	grid.Insert("a", Rect(0, 0, 2, 2), 1)
+	grid.Insert("b", Rect(1, 1, 2, 2), 2)
+	grid.Insert("c", Rect(20, 20, 2, 2), 1)
+	grid.Insert("d", Circle{Center: objects.Vector2D{X: 1, Y: 1}, Radius: 1}, 4)
+	grid.Insert("e", Rect(-9, 0, 10, 2), 1) // spans several cells
+	if grid.Len() != 5 {
+		t.Errorf("Len = %d, want 5", grid.Len())
+	}
+
+	near := Rect(0, 0, 3, 3)
+	tests := []struct {
+		name  string
+		shape Shape
+		mask  Layer
+		want  []string
+	}{
+		{"layer 1", near, 1, []string{"a", "e"}},
+		{"layers 1 and 2", near, 1 | 2, []string{"a", "b", "e"}},
+		{"every layer", near, AllLayers, []string{"a", "b", "d", "e"}},
+		{"no layer", near, 0, nil},
+		{"unused layer", near, 8, nil},
+		{"far away", Rect(20.5, 20.5, 1, 1), AllLayers, []string{"c"}},
+		{"empty space", Rect(10, 10, 2, 2), AllLayers, nil},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, hit := range grid.Query(tt.shape, tt.mask) {
+			got = append(got, hit.Item)
+			if hit.Layer&tt.mask == 0 {
+				t.Errorf("%s: hit %s on layer %d outside the mask", tt.name, hit.Item, hit.Layer)
+			}
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: Query = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	grid.Clear()
+	if hits := grid.Query(near, AllLayers); grid.Len() != 0 || len(hits) != 0 {
+		t.Errorf("after Clear: Len = %d, hits = %v", grid.Len(), hits)
+	}
+}
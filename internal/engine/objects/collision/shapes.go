@@ -0,0 +1,83 @@
+// Package collision detects overlaps between shapes. AABB and Circle shapes
+// are tested with Collide, which also returns contact info for resolving the
+// overlap, and a Grid finds the candidates worth testing.
+package collision
+
+import (
+	"math"
+
+	"github.com/kuhree/gg/internal/engine/objects"
+)
+
+// Shape is anything Collide can test
+type Shape interface {
+	Bounds() AABB
+}
+
+// AABB is an axis-aligned box from Min, its top-left corner, to Max
+type AABB struct {
+	Min, Max objects.Vector2D
+}
+
+// Rect creates a box from its top-left corner and size
+func Rect(x, y, width, height float64) AABB {
+	return AABB{
+		Min: objects.Vector2D{X: x, Y: y},
+		Max: objects.Vector2D{X: x + width, Y: y + height},
+	}
+}
+
+// Centered creates a box of the given size around center
+func Centered(center objects.Vector2D, width, height float64) AABB {
+	half := objects.Vector2D{X: width / 2, Y: height / 2}
+	return AABB{Min: center.Sub(half), Max: center.Add(half)}
+}
+
+// Box returns the box covered by a game object positioned by its top-left corner
+func Box(o objects.GameObject) AABB {
+	return Rect(o.Position.X, o.Position.Y, o.Width, o.Height)
+}
+
+// Bounds returns the box itself
+func (a AABB) Bounds() AABB {
+	return a
+}
+
+// Center returns the center of the box
+func (a AABB) Center() objects.Vector2D {
+	return a.Min.Lerp(a.Max, 0.5)
+}
+
+// Size returns the width and height of the box
+func (a AABB) Size() (float64, float64) {
+	return a.Max.X - a.Min.X, a.Max.Y - a.Min.Y
+}
+
+// Contains reports whether p lies inside the box
+func (a AABB) Contains(p objects.Vector2D) bool {
+	return p.X >= a.Min.X && p.X < a.Max.X && p.Y >= a.Min.Y && p.Y < a.Max.Y
+}
+
+// Translate returns the box moved by d
+func (a AABB) Translate(d objects.Vector2D) AABB {
+	return AABB{Min: a.Min.Add(d), Max: a.Max.Add(d)}
+}
+
+// closest returns the point of the box nearest to p
+func (a AABB) closest(p objects.Vector2D) objects.Vector2D {
+	return objects.Vector2D{
+		X: math.Max(a.Min.X, math.Min(p.X, a.Max.X)),
+		Y: math.Max(a.Min.Y, math.Min(p.Y, a.Max.Y)),
+	}
+}
+
+// Circle is a circle around Center
+type Circle struct {
+	Center objects.Vector2D
+	Radius float64
+}
+
+// Bounds returns the box around the circle
+func (c Circle) Bounds() AABB {
+	return Centered(c.Center, c.Radius*2, c.Radius*2)
+}
@@ -5,44 +5,11 @@ type Vector2D struct {
 	X, Y float64
 }
 
-// GameObject represents a basic game entity
+// GameObject represents a basic game entity. The collision package tests
+// objects against each other and the physics package moves them as rigid
+// bodies.
 type GameObject struct {
 	Position Vector2D
 	Height   float64
 	Width    float64
 }
-
-// The key components needed for a basic 2D physics engine:
-//
-// 1. Vector2D class
-//    - Basic vector operations (add, subtract, multiply, dot product)
-//    - Vector magnitude and normalization
-//
-// 2. RigidBody class
-//    - Position, velocity, acceleration
-//    - Mass, rotation, angular velocity
-//    - Force accumulator
-//    - Update method for physics calculations
-//
-// 3. Shape classes for collision
-//    - Circle (simplest to implement)
-//    - Rectangle/Box
-//    - Polygon (more complex)
-//    - Collision detection methods
-//
-// 4. World/Space class
-//    - Manages all physics objects
-//    - Handles gravity
-//    - Updates all objects each frame
-//    - Collision detection and resolution
-//
-// 5. Collision system
-//    - Broad phase (divide space into regions)
-//    - Narrow phase (precise collision checks)
-//    - Collision response (impulse resolution)
-//
-// 6. Integration system
-//    - Euler integration (simple but less accurate)
-//    - Verlet integration (more stable)
-//    - RK4 integration (most accurate but complex)
-//
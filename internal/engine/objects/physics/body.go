@@ -0,0 +1,108 @@
+// Package physics moves game objects as rigid bodies. A World integrates the
+// forces on its bodies every Step and resolves their collisions with
+// impulses, e.g.
+//
+//	world := physics.NewWorld(objects.Vector2D{Y: 20})
+//	world.Add(physics.NewBody(&bird.GameObject, 1))
+//	world.Step(dt)
+package physics
+
+import (
+	"math"
+
+	"github.com/kuhree/gg/internal/engine/objects"
+	"github.com/kuhree/gg/internal/engine/objects/collision"
+)
+
+// Body is a rigid body moving a game object. Bodies without mass are static:
+// gravity, forces and collisions don't move them, but the game still can.
+type Body struct {
+	// Object is the game object the body moves, positioned by its top-left corner
+	Object   *objects.GameObject
+	Velocity objects.Vector2D
+
+	// Restitution is how bouncy the body is, 0 stops dead and 1 keeps all
+	// its speed. Colliding bodies bounce with the bouncier of the two.
+	Restitution float64
+	// Damping slows the body down by this fraction of its velocity per second
+	Damping float64
+
+	// Round bodies collide as the circle inside their object instead of its box
+	Round bool
+	// Layer is the collision layer the body is on, it only collides with
+	// bodies on the layers in Mask
+	Layer collision.Layer
+	Mask  collision.Layer
+
+	// OnContact is called each step the body moves into another one, after
+	// the collision is resolved. The contact's normal points away from other.
+	OnContact func(other *Body, contact collision.Contact)
+
+	mass        float64
+	inverseMass float64
+	force       objects.Vector2D
+	world       *World
+}
+
+// NewBody creates a body moving object with the given mass, 0 for a static body
+func NewBody(object *objects.GameObject, mass float64) *Body {
+	b := &Body{
+		Object: object,
+		Layer:  1,
+		Mask:   collision.AllLayers,
+	}
+	b.SetMass(mass)
+	return b
+}
+
+// Mass returns the mass of the body, 0 if it's static
+func (b *Body) Mass() float64 {
+	return b.mass
+}
+
+// SetMass changes the mass of the body, 0 or less makes it static
+func (b *Body) SetMass(mass float64) {
+	if mass <= 0 {
+		b.mass, b.inverseMass = 0, 0
+		return
+	}
+	b.mass, b.inverseMass = mass, 1/mass
+}
+
+// Static reports whether the body has no mass
+func (b *Body) Static() bool {
+	return b.inverseMass == 0
+}
+
+// ApplyForce pushes the body with force during the next Step
+func (b *Body) ApplyForce(force objects.Vector2D) {
+	b.force = b.force.Add(force)
+}
+
+// ApplyImpulse changes the velocity of the body right away
+func (b *Body) ApplyImpulse(impulse objects.Vector2D) {
+	b.Velocity = b.Velocity.Add(impulse.Scale(b.inverseMass))
+}
+
+// Shape returns the shape the body collides as, where its object is now
+func (b *Body) Shape() collision.Shape {
+	box := collision.Box(*b.Object)
+	if b.Round {
+		return collision.Circle{Center: box.Center(), Radius: math.Min(b.Object.Width, b.Object.Height) / 2}
+	}
+	return box
+}
+
+// World returns the world the body was added to, or nil
+func (b *Body) World() *World {
+	return b.world
+}
+
+// acceleration returns the acceleration of the body moving at velocity
+// under gravity and the forces applied to it
+func (b *Body) acceleration(gravity objects.Vector2D) Acceleration {
+	push := gravity.Add(b.force.Scale(b.inverseMass))
+	return func(velocity objects.Vector2D) objects.Vector2D {
+		return push.Sub(velocity.Scale(b.Damping))
+	}
+}
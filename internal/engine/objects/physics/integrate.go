@@ -0,0 +1,45 @@
+package physics
+
+import "github.com/kuhree/gg/internal/engine/objects"
+
+// Acceleration returns the acceleration of a body moving at velocity
+type Acceleration func(velocity objects.Vector2D) objects.Vector2D
+
+// Integrator advances a body's position and velocity by dt
+type Integrator func(position, velocity objects.Vector2D, accel Acceleration, dt float64) (objects.Vector2D, objects.Vector2D)
+
+// Euler updates the velocity, then moves at the new velocity. It's the
+// cheapest integrator and stable enough for most arcade games.
+func Euler(position, velocity objects.Vector2D, accel Acceleration, dt float64) (objects.Vector2D, objects.Vector2D) {
+	velocity = velocity.Add(accel(velocity).Scale(dt))
+	return position.Add(velocity.Scale(dt)), velocity
+}
+
+// Verlet moves along the curve the acceleration bends the body into, then
+// updates the velocity with the average acceleration over the step. It's
+// exact under constant acceleration, so jumps keep the same height whatever
+// the frame rate.
+func Verlet(position, velocity objects.Vector2D, accel Acceleration, dt float64) (objects.Vector2D, objects.Vector2D) {
+	a := accel(velocity)
+	position = position.Add(velocity.Scale(dt)).Add(a.Scale(dt * dt / 2))
+	next := accel(velocity.Add(a.Scale(dt)))
+	return position, velocity.Add(a.Add(next).Scale(dt / 2))
+}
+
+// RK4 samples the acceleration four times per step, the most accurate of the
+// integrators when it changes with the velocity, e.g. with damping
+func RK4(position, velocity objects.Vector2D, accel Acceleration, dt float64) (objects.Vector2D, objects.Vector2D) {
+	k1v := accel(velocity)
+	k1x := velocity
+	k2v := accel(velocity.Add(k1v.Scale(dt / 2)))
+	k2x := velocity.Add(k1v.Scale(dt / 2))
+	k3v := accel(velocity.Add(k2v.Scale(dt / 2)))
+	k3x := velocity.Add(k2v.Scale(dt / 2))
+	k4v := accel(velocity.Add(k3v.Scale(dt)))
+	k4x := velocity.Add(k3v.Scale(dt))
+
+	// Weighted average of the four samples: (k1 + 2*k2 + 2*k3 + k4) / 6
+	dx := k1x.Add(k2x.Scale(2)).Add(k3x.Scale(2)).Add(k4x).Scale(dt / 6)
+	dv := k1v.Add(k2v.Scale(2)).Add(k3v.Scale(2)).Add(k4v).Scale(dt / 6)
+	return position.Add(dx), velocity.Add(dv)
+}
@@ -0,0 +1,172 @@
+package physics
+
+import (
+	"math"
+	"slices"
+
+	"github.com/kuhree/gg/internal/engine/objects"
+	"github.com/kuhree/gg/internal/engine/objects/collision"
+)
+
+const (
+	// cellSize is the broad phase grid cell size, about the size of most game objects
+	cellSize = 8
+	// restingSpeed is the speed, in cells per second, below which bodies stop
+	// against each other instead of bouncing, so resting bodies don't jitter
+	restingSpeed = 1
+)
+
+// World holds the bodies that move and collide together
+type World struct {
+	Gravity objects.Vector2D
+	// Integrator moves the bodies each step, Euler by default
+	Integrator Integrator
+
+	bodies []*Body
+	grid   *collision.Grid[*Body]
+
+	// stepping is set during Step, removed bodies are dropped from the
+	// list once it's done
+	stepping bool
+}
+
+// NewWorld creates an empty world with the given gravity, in cells per second squared
+func NewWorld(gravity objects.Vector2D) *World {
+	return &World{
+		Gravity:    gravity,
+		Integrator: Euler,
+		grid:       collision.NewGrid[*Body](cellSize),
+	}
+}
+
+// Add adds bodies to the world, moving them out of any other world
+func (w *World) Add(bodies ...*Body) {
+	for _, b := range bodies {
+		if b.world == w {
+			continue
+		}
+		if b.world != nil {
+			b.world.Remove(b)
+		}
+		b.world = w
+		// A body removed during this step may still be on the list
+		if !slices.Contains(w.bodies, b) {
+			w.bodies = append(w.bodies, b)
+		}
+	}
+}
+
+// Remove takes a body out of the world. It's safe to call from OnContact.
+func (w *World) Remove(b *Body) {
+	if b.world != w {
+		return
+	}
+	b.world = nil
+	if !w.stepping {
+		w.compact()
+	}
+}
+
+// Clear removes every body
+func (w *World) Clear() {
+	for _, b := range w.bodies {
+		b.world = nil
+	}
+	if !w.stepping {
+		w.compact()
+	}
+}
+
+// Bodies returns the bodies in the world, in the order they were added
+func (w *World) Bodies() []*Body {
+	return slices.Clone(w.bodies)
+}
+
+// Step moves the bodies forward by dt seconds, then resolves the collisions
+// between them
+func (w *World) Step(dt float64) {
+	w.stepping = true
+	defer func() {
+		w.stepping = false
+		w.compact()
+	}()
+
+	for _, b := range w.bodies {
+		if b.world != w || b.Static() {
+			continue
+		}
+		b.Object.Position, b.Velocity = w.Integrator(b.Object.Position, b.Velocity, b.acceleration(w.Gravity), dt)
+		b.force = objects.Vector2D{}
+	}
+
+	w.collide()
+}
+
+// collide finds the overlapping bodies with the grid and resolves each pair once
+func (w *World) collide() {
+	w.grid.Clear()
+	for _, b := range w.bodies {
+		w.grid.Insert(b, b.Shape(), b.Layer)
+	}
+
+	for i, a := range w.bodies {
+		if a.world != w || a.Static() {
+			continue
+		}
+		for _, hit := range w.grid.Query(a.Shape(), a.Mask) {
+			b := hit.Item
+			if b == a || b.world != w || b.Mask&a.Layer == 0 {
+				continue
+			}
+			// Pairs of moving bodies are found from both sides, keep the first
+			if !b.Static() && slices.Index(w.bodies, b) < i {
+				continue
+			}
+			// Earlier resolutions may have moved either body since the grid was built
+			if contact, ok := collision.Collide(a.Shape(), b.Shape()); ok {
+				resolve(a, b, contact)
+			}
+		}
+	}
+}
+
+// resolve separates two overlapping bodies and, if they're moving into each
+// other, bounces them apart with an impulse along the contact normal
+func resolve(a, b *Body, contact collision.Contact) {
+	inverseMass := a.inverseMass + b.inverseMass
+	if inverseMass == 0 {
+		return
+	}
+
+	// Push the bodies apart in proportion to their inverse masses
+	correction := contact.Normal.Scale(contact.Penetration / inverseMass)
+	a.Object.Position = a.Object.Position.Add(correction.Scale(a.inverseMass))
+	b.Object.Position = b.Object.Position.Sub(correction.Scale(b.inverseMass))
+
+	approach := a.Velocity.Sub(b.Velocity).Dot(contact.Normal)
+	if approach >= 0 {
+		return
+	}
+
+	restitution := math.Max(a.Restitution, b.Restitution)
+	if -approach < restingSpeed {
+		restitution = 0
+	}
+	impulse := contact.Normal.Scale(-(1 + restitution) * approach / inverseMass)
+	a.ApplyImpulse(impulse)
+	b.ApplyImpulse(impulse.Neg())
+
+	if a.OnContact != nil {
+		a.OnContact(b, contact)
+	}
+	if b.OnContact != nil {
+		b.OnContact(a, collision.Contact{Normal: contact.Normal.Neg(), Penetration: contact.Penetration})
+	}
+}
+
+// compact drops the removed bodies from the list
+func (w *World) compact() {
+	w.bodies = slices.DeleteFunc(w.bodies, func(b *Body) bool {
+		return b.world != w
+	})
+}
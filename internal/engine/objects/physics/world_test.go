@@ -0,0 +1,227 @@
+package physics
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/kuhree/gg/internal/engine/objects"
+	"github.com/kuhree/gg/internal/engine/objects/collision"
+)
+
+// newBox creates a body moving a w x h object at x, y
+func newBox(x, y, w, h, mass float64) *Body {
+	return NewBody(&objects.GameObject{Position: objects.Vector2D{X: x, Y: y}, Width: w, Height: h}, mass)
+}
+
+func TestStepUnderGravity(t *testing.T) {
+	// Falling from rest under 10 cells/s² for 1s, in steps of 0.1s
+	tests := []struct {
+		name       string
+		integrator Integrator
+		damping    float64
+		y, vy      float64
+	}{
+		// Euler moves at the velocity at the end of each step, so it overshoots
+		{"Euler", Euler, 0, 5.5, 10},
+		{"Verlet", Verlet, 0, 5, 10},
+		{"RK4", RK4, 0, 5, 10},
+		// With damping 1, v = 10(1 - e^-t) and y = 10e^-t at t = 1
+		{"RK4 damped", RK4, 1, 10 / math.E, 10 * (1 - 1/math.E)},
+	}
+	for _, tt := range tests {
+		world := NewWorld(objects.Vector2D{Y: 10})
+		world.Integrator = tt.integrator
+		body := newBox(3, 0, 1, 1, 1)
+		body.Damping = tt.damping
+		wall := newBox(-20, -20, 1, 1, 0)
+		world.Add(body, wall)
+
+		for i := 0; i < 10; i++ {
+			world.Step(0.1)
+		}
+
+		if math.Abs(body.Object.Position.Y-tt.y) > 1e-4 || math.Abs(body.Velocity.Y-tt.vy) > 1e-4 {
+			t.Errorf("%s: y, vy = %v, %v, want %v, %v", tt.name, body.Object.Position.Y, body.Velocity.Y, tt.y, tt.vy)
+		}
+		if body.Object.Position.X != 3 {
+			t.Errorf("%s: x = %v, want 3", tt.name, body.Object.Position.X)
+		}
+		if wall.Object.Position != (objects.Vector2D{X: -20, Y: -20}) {
+			t.Errorf("%s: static body moved to %v", tt.name, wall.Object.Position)
+		}
+	}
+}
+
+func TestApplyForce(t *testing.T) {
+	world := NewWorld(objects.Vector2D{})
+	body := newBox(0, 0, 1, 1, 2)
+	world.Add(body)
+
+	body.ApplyForce(objects.Vector2D{X: 20})
+	world.Step(0.5)
+	if body.Velocity.X != 5 {
+		t.Errorf("velocity after pushing = %v, want 5", body.Velocity.X)
+	}
+	// Forces only last one step
+	world.Step(0.5)
+	if body.Velocity.X != 5 {
+		t.Errorf("velocity a step later = %v, want 5", body.Velocity.X)
+	}
+
+	body.ApplyImpulse(objects.Vector2D{Y: -4})
+	if body.Velocity.Y != -2 {
+		t.Errorf("velocity after an impulse = %v, want -2", body.Velocity.Y)
+	}
+}
+
+func TestBounceOffStaticBody(t *testing.T) {
+	tests := []struct {
+		restitution, speed float64
+		want               float64
+	}{
+		{1, 10, -10},
+		{0.5, 10, -5},
+		{0, 10, 0},
+		// Too slow to bounce, it comes to rest
+		{1, 0.5, 0},
+	}
+	for _, tt := range tests {
+		world := NewWorld(objects.Vector2D{})
+		ball := newBox(0, 0, 1, 1, 1)
+		ball.Velocity.Y = tt.speed
+		ball.Restitution = tt.restitution
+		floor := newBox(-5, 1+tt.speed*0.01/2, 10, 1, 0)
+		world.Add(ball, floor)
+
+		var contacts []collision.Contact
+		floor.OnContact = func(other *Body, contact collision.Contact) {
+			if other != ball {
+				t.Errorf("floor touched %v, want the ball", other)
+			}
+			contacts = append(contacts, contact)
+		}
+		world.Step(0.01)
+
+		if math.Abs(ball.Velocity.Y-tt.want) > 1e-9 {
+			t.Errorf("restitution %v at %v: bounced at %v, want %v", tt.restitution, tt.speed, ball.Velocity.Y, tt.want)
+		}
+		if bottom := ball.Object.Position.Y + 1; bottom > floor.Object.Position.Y+1e-9 {
+			t.Errorf("restitution %v at %v: ball still in the floor", tt.restitution, tt.speed)
+		}
+		if floor.Object.Position.Y != 1+tt.speed*0.01/2 || floor.Velocity != (objects.Vector2D{}) {
+			t.Errorf("restitution %v at %v: static floor moved", tt.restitution, tt.speed)
+		}
+		if len(contacts) != 1 || contacts[0].Normal != objects.Down {
+			t.Errorf("restitution %v at %v: floor contacts = %v, want one pointing down", tt.restitution, tt.speed, contacts)
+		}
+	}
+}
+
+func TestCollideDynamicBodies(t *testing.T) {
+	tests := []struct {
+		name               string
+		massB, restitution float64
+		va, vb             float64
+	}{
+		// Equal masses swap velocities in an elastic collision
+		{"elastic", 1, 1, -10, 10},
+		// A perfectly inelastic collision moves both at the shared momentum
+		{"inelastic", 3, 0, 2.5, 2.5},
+	}
+	for _, tt := range tests {
+		world := NewWorld(objects.Vector2D{})
+		a := newBox(0, 0, 1, 1, 1)
+		a.Velocity.X = 10
+		a.Restitution = tt.restitution
+		b := newBox(1.05, 0, 1, 1, tt.massB)
+		if tt.massB == 1 {
+			b.Velocity.X = -10
+		}
+		world.Add(a, b)
+
+		momentum := a.Velocity.X*a.Mass() + b.Velocity.X*b.Mass()
+		world.Step(0.01)
+
+		if math.Abs(a.Velocity.X-tt.va) > 1e-9 || math.Abs(b.Velocity.X-tt.vb) > 1e-9 {
+			t.Errorf("%s: velocities = %v, %v, want %v, %v", tt.name, a.Velocity.X, b.Velocity.X, tt.va, tt.vb)
+		}
+		if after := a.Velocity.X*a.Mass() + b.Velocity.X*b.Mass(); math.Abs(after-momentum) > 1e-9 {
+			t.Errorf("%s: momentum went from %v to %v", tt.name, momentum, after)
+		}
+		if collision.Overlaps(a.Shape(), b.Shape()) {
+			t.Errorf("%s: bodies still overlap", tt.name)
+		}
+	}
+}
+
+func TestLayers(t *testing.T) {
+	world := NewWorld(objects.Vector2D{})
+	ball := newBox(0, 0, 1, 1, 1)
+	ball.Velocity.Y = 10
+	ball.Mask = 2
+	floor := newBox(-5, 1.05, 10, 1, 0) // on layer 1
+	world.Add(ball, floor)
+
+	world.Step(0.01)
+	if ball.Velocity.Y != 10 {
+		t.Errorf("ball bounced off a layer outside its mask")
+	}
+}
+
+func TestRemoveDuringStep(t *testing.T) {
+	world := NewWorld(objects.Vector2D{})
+	ball := newBox(0, 0, 1, 1, 1)
+	ball.Velocity.Y = -10
+	ball.Restitution = 1
+	brick := newBox(-2, -1.05, 5, 1, 0)
+	other := newBox(10, 10, 1, 1, 1)
+	world.Add(ball, brick, other)
+
+	hits := 0
+	brick.OnContact = func(_ *Body, _ collision.Contact) {
+		hits++
+		world.Remove(brick)
+		// Removing twice is harmless
+		world.Remove(brick)
+	}
+	world.Step(0.01)
+
+	if hits != 1 || ball.Velocity.Y != 10 {
+		t.Errorf("hits = %d, ball velocity = %v, want 1 bounce", hits, ball.Velocity.Y)
+	}
+	if brick.World() != nil || slices.Contains(world.Bodies(), brick) {
+		t.Error("brick still in the world")
+	}
+	if got := world.Bodies(); !slices.Equal(got, []*Body{ball, other}) {
+		t.Errorf("Bodies = %v, want the ball and the other body", got)
+	}
+
+	// The ball passes where the brick was
+	ball.Velocity.Y = -10
+	world.Step(0.2)
+	if hits != 1 || ball.Velocity.Y != -10 {
+		t.Errorf("removed brick still collides")
+	}
+
+	// Removed bodies can be added again
+	world.Add(brick)
+	if brick.World() != world || len(world.Bodies()) != 3 {
+		t.Error("brick wasn't added back")
+	}
+}
+
+func TestAddMovesBetweenWorlds(t *testing.T) {
+	first, second := NewWorld(objects.Vector2D{}), NewWorld(objects.Vector2D{})
+	body := newBox(0, 0, 1, 1, 1)
+	first.Add(body)
+	second.Add(body)
+	if len(first.Bodies()) != 0 || body.World() != second {
+		t.Error("body wasn't moved to the second world")
+	}
+
+	second.Clear()
+	if len(second.Bodies()) != 0 || body.World() != nil {
+		t.Error("Clear left the body in the world")
+	}
+}
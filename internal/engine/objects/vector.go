@@ -0,0 +1,129 @@
+package objects
+
+import "math"
+
+// Epsilon is the default tolerance for comparing vectors
+const Epsilon = 1e-9
+
+// Unit vectors in screen space, where y grows downwards
+var (
+	Up    = Vector2D{Y: -1}
+	Down  = Vector2D{Y: 1}
+	Left  = Vector2D{X: -1}
+	Right = Vector2D{X: 1}
+)
+
+// FromAngle returns a vector of the given length pointing at angle radians,
+// 0 pointing right and Pi/2 down
+func FromAngle(angle, length float64) Vector2D {
+	return Vector2D{X: math.Cos(angle) * length, Y: math.Sin(angle) * length}
+}
+
+// FromCell returns the position of the top-left corner of a cell
+func FromCell(x, y int) Vector2D {
+	return Vector2D{X: float64(x), Y: float64(y)}
+}
+
+// Cell returns the cell the vector falls in
+func (v Vector2D) Cell() (int, int) {
+	return int(math.Floor(v.X)), int(math.Floor(v.Y))
+}
+
+// RoundCell returns the cell nearest to the vector
+func (v Vector2D) RoundCell() (int, int) {
+	return int(math.Round(v.X)), int(math.Round(v.Y))
+}
+
+// Add returns v + o
+func (v Vector2D) Add(o Vector2D) Vector2D {
+	return Vector2D{X: v.X + o.X, Y: v.Y + o.Y}
+}
+
+// Sub returns v - o
+func (v Vector2D) Sub(o Vector2D) Vector2D {
+	return Vector2D{X: v.X - o.X, Y: v.Y - o.Y}
+}
+
+// Scale returns v multiplied by f
+func (v Vector2D) Scale(f float64) Vector2D {
+	return Vector2D{X: v.X * f, Y: v.Y * f}
+}
+
+// Neg returns v pointing the other way
+func (v Vector2D) Neg() Vector2D {
+	return Vector2D{X: -v.X, Y: -v.Y}
+}
+
+// Dot returns the dot product of v and o
+func (v Vector2D) Dot(o Vector2D) float64 {
+	return v.X*o.X + v.Y*o.Y
+}
+
+// Cross returns the z component of the cross product of v and o. It's
+// positive when o is clockwise from v on screen.
+func (v Vector2D) Cross(o Vector2D) float64 {
+	return v.X*o.Y - v.Y*o.X
+}
+
+// Length returns the length of v
+func (v Vector2D) Length() float64 {
+	return math.Hypot(v.X, v.Y)
+}
+
+// LengthSquared returns the squared length of v, cheaper than Length for comparisons
+func (v Vector2D) LengthSquared() float64 {
+	return v.Dot(v)
+}
+
+// Normalize returns v scaled to length 1, or the zero vector if v is zero
+func (v Vector2D) Normalize() Vector2D {
+	length := v.Length()
+	if length == 0 {
+		return Vector2D{}
+	}
+	return v.Scale(1 / length)
+}
+
+// Distance returns the distance between v and o
+func (v Vector2D) Distance(o Vector2D) float64 {
+	return v.Sub(o).Length()
+}
+
+// DistanceSquared returns the squared distance between v and o
+func (v Vector2D) DistanceSquared(o Vector2D) float64 {
+	return v.Sub(o).LengthSquared()
+}
+
+// Lerp returns the point t of the way from v to o, 0 being v and 1 being o
+func (v Vector2D) Lerp(o Vector2D, t float64) Vector2D {
+	return Vector2D{X: v.X + (o.X-v.X)*t, Y: v.Y + (o.Y-v.Y)*t}
+}
+
+// Rotate returns v rotated by angle radians, clockwise on screen
+func (v Vector2D) Rotate(angle float64) Vector2D {
+	sin, cos := math.Sincos(angle)
+	return Vector2D{X: v.X*cos - v.Y*sin, Y: v.X*sin + v.Y*cos}
+}
+
+// Reflect returns v bounced off a surface with the given unit normal
+func (v Vector2D) Reflect(normal Vector2D) Vector2D {
+	return v.Sub(normal.Scale(2 * v.Dot(normal)))
+}
+
+// Angle returns the direction of v in radians, 0 pointing right and Pi/2 down
+func (v Vector2D) Angle() float64 {
+	return math.Atan2(v.Y, v.X)
+}
+
+// ClampLength returns v shortened to at most maxLength, keeping its direction
+func (v Vector2D) ClampLength(maxLength float64) Vector2D {
+	if length := v.Length(); length > maxLength && length > 0 {
+		return v.Scale(maxLength / length)
+	}
+	return v
+}
+
+// ApproxEqual reports whether v and o differ by at most epsilon on each axis
+func (v Vector2D) ApproxEqual(o Vector2D, epsilon float64) bool {
+	return math.Abs(v.X-o.X) <= epsilon && math.Abs(v.Y-o.Y) <= epsilon
+}
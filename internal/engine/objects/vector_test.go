@@ -0,0 +1,247 @@
+package objects
+
+import (
+	"math"
+	"testing"
+)
+
+func TestVectorArithmetic(t *testing.T) {
+	v, o := Vector2D{X: 3, Y: 4}, Vector2D{X: -1, Y: 2}
+	tests := []struct {
+		name string
+		got  Vector2D
+		want Vector2D
+	}{
+		{"Add", v.Add(o), Vector2D{X: 2, Y: 6}},
+		{"Add zero", v.Add(Vector2D{}), v},
+		{"Sub", v.Sub(o), Vector2D{X: 4, Y: 2}},
+		{"Sub self", v.Sub(v), Vector2D{}},
+		{"Scale", v.Scale(2), Vector2D{X: 6, Y: 8}},
+		{"Scale negative", v.Scale(-0.5), Vector2D{X: -1.5, Y: -2}},
+		{"Scale zero", v.Scale(0), Vector2D{}},
+		{"Neg", v.Neg(), Vector2D{X: -3, Y: -4}},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestVectorProducts(t *testing.T) {
+	tests := []struct {
+		v, o       Vector2D
+		dot, cross float64
+	}{
+		{Vector2D{X: 3, Y: 4}, Vector2D{X: -1, Y: 2}, 5, 10},
+		{Right, Down, 0, 1},
+		{Down, Right, 0, -1},
+		{Right, Right, 1, 0},
+		{Right, Left, -1, 0},
+		{Vector2D{}, Vector2D{X: 5, Y: 5}, 0, 0},
+	}
+	for _, tt := range tests {
+		if got := tt.v.Dot(tt.o); got != tt.dot {
+			t.Errorf("%v.Dot(%v) = %v, want %v", tt.v, tt.o, got, tt.dot)
+		}
+		if got := tt.v.Cross(tt.o); got != tt.cross {
+			t.Errorf("%v.Cross(%v) = %v, want %v", tt.v, tt.o, got, tt.cross)
+		}
+	}
+}
+
+func TestVectorLength(t *testing.T) {
+	tests := []struct {
+		v             Vector2D
+		length, lenSq float64
+		normalized    Vector2D
+	}{
+		{Vector2D{X: 3, Y: 4}, 5, 25, Vector2D{X: 0.6, Y: 0.8}},
+		{Vector2D{X: -3, Y: -4}, 5, 25, Vector2D{X: -0.6, Y: -0.8}},
+		{Vector2D{Y: -2}, 2, 4, Up},
+		{Vector2D{}, 0, 0, Vector2D{}},
+	}
+	for _, tt := range tests {
+		if got := tt.v.Length(); got != tt.length {
+			t.Errorf("%v.Length() = %v, want %v", tt.v, got, tt.length)
+		}
+		if got := tt.v.LengthSquared(); got != tt.lenSq {
+			t.Errorf("%v.LengthSquared() = %v, want %v", tt.v, got, tt.lenSq)
+		}
+		if got := tt.v.Normalize(); !got.ApproxEqual(tt.normalized, Epsilon) {
+			t.Errorf("%v.Normalize() = %v, want %v", tt.v, got, tt.normalized)
+		}
+	}
+}
+
+func TestVectorDistance(t *testing.T) {
+	tests := []struct {
+		v, o           Vector2D
+		distance, dist float64
+	}{
+		{Vector2D{X: 1, Y: 1}, Vector2D{X: 4, Y: 5}, 5, 25},
+		{Vector2D{X: 4, Y: 5}, Vector2D{X: 1, Y: 1}, 5, 25},
+		{Vector2D{X: 2, Y: 2}, Vector2D{X: 2, Y: 2}, 0, 0},
+	}
+	for _, tt := range tests {
+		if got := tt.v.Distance(tt.o); got != tt.distance {
+			t.Errorf("%v.Distance(%v) = %v, want %v", tt.v, tt.o, got, tt.distance)
+		}
+		if got := tt.v.DistanceSquared(tt.o); got != tt.dist {
+			t.Errorf("%v.DistanceSquared(%v) = %v, want %v", tt.v, tt.o, got, tt.dist)
+		}
+	}
+}
+
+func TestVectorLerp(t *testing.T) {
+	v, o := Vector2D{X: 0, Y: 10}, Vector2D{X: 10, Y: 20}
+	tests := []struct {
+		t    float64
+		want Vector2D
+	}{
+		{0, v},
+		{1, o},
+		{0.5, Vector2D{X: 5, Y: 15}},
+		{2, Vector2D{X: 20, Y: 30}},
+		{-1, Vector2D{X: -10, Y: 0}},
+	}
+	for _, tt := range tests {
+		if got := v.Lerp(o, tt.t); !got.ApproxEqual(tt.want, Epsilon) {
+			t.Errorf("Lerp(%v) = %v, want %v", tt.t, got, tt.want)
+		}
+	}
+}
+
+func TestVectorRotate(t *testing.T) {
+	tests := []struct {
+		v     Vector2D
+		angle float64
+		want  Vector2D
+	}{
+		{Right, 0, Right},
+		{Right, math.Pi / 2, Down},
+		{Right, math.Pi, Left},
+		{Right, -math.Pi / 2, Up},
+		{Up, math.Pi / 2, Right},
+		{Vector2D{X: 3, Y: 4}, 2 * math.Pi, Vector2D{X: 3, Y: 4}},
+		{Vector2D{}, 1, Vector2D{}},
+	}
+	for _, tt := range tests {
+		if got := tt.v.Rotate(tt.angle); !got.ApproxEqual(tt.want, Epsilon) {
+			t.Errorf("%v.Rotate(%v) = %v, want %v", tt.v, tt.angle, got, tt.want)
+		}
+	}
+}
+
+func TestVectorReflect(t *testing.T) {
+	tests := []struct {
+		v, normal Vector2D
+		want      Vector2D
+	}{
+		{Vector2D{X: 1, Y: 1}, Up, Vector2D{X: 1, Y: -1}},
+		{Vector2D{X: 1, Y: 1}, Left, Vector2D{X: -1, Y: 1}},
+		{Vector2D{X: 3, Y: -2}, Down, Vector2D{X: 3, Y: 2}},
+		{Vector2D{X: 1, Y: 0}, Vector2D{X: -1, Y: 1}.Normalize(), Vector2D{X: 0, Y: 1}},
+		{Vector2D{X: 2, Y: 0}, Up, Vector2D{X: 2, Y: 0}},
+	}
+	for _, tt := range tests {
+		if got := tt.v.Reflect(tt.normal); !got.ApproxEqual(tt.want, Epsilon) {
+			t.Errorf("%v.Reflect(%v) = %v, want %v", tt.v, tt.normal, got, tt.want)
+		}
+	}
+}
+
+func TestVectorAngle(t *testing.T) {
+	tests := []struct {
+		v    Vector2D
+		want float64
+	}{
+		{Right, 0},
+		{Down, math.Pi / 2},
+		{Up, -math.Pi / 2},
+		{Left, math.Pi},
+		{Vector2D{X: 1, Y: 1}, math.Pi / 4},
+		{Vector2D{}, 0},
+	}
+	for _, tt := range tests {
+		if got := tt.v.Angle(); math.Abs(got-tt.want) > Epsilon {
+			t.Errorf("%v.Angle() = %v, want %v", tt.v, got, tt.want)
+		}
+	}
+
+	// FromAngle is the inverse of Angle and Length
+	for _, tt := range tests[:5] {
+		if got := FromAngle(tt.want, tt.v.Length()); !got.ApproxEqual(tt.v, Epsilon) {
+			t.Errorf("FromAngle(%v, %v) = %v, want %v", tt.want, tt.v.Length(), got, tt.v)
+		}
+	}
+}
+
+func TestVectorClampLength(t *testing.T) {
+	tests := []struct {
+		v         Vector2D
+		maxLength float64
+		want      Vector2D
+	}{
+		{Vector2D{X: 3, Y: 4}, 10, Vector2D{X: 3, Y: 4}},
+		{Vector2D{X: 3, Y: 4}, 5, Vector2D{X: 3, Y: 4}},
+		{Vector2D{X: 3, Y: 4}, 2.5, Vector2D{X: 1.5, Y: 2}},
+		{Vector2D{X: -6, Y: -8}, 5, Vector2D{X: -3, Y: -4}},
+		{Vector2D{X: 3, Y: 4}, 0, Vector2D{}},
+		{Vector2D{}, 1, Vector2D{}},
+		{Vector2D{}, 0, Vector2D{}},
+	}
+	for _, tt := range tests {
+		if got := tt.v.ClampLength(tt.maxLength); !got.ApproxEqual(tt.want, Epsilon) {
+			t.Errorf("%v.ClampLength(%v) = %v, want %v", tt.v, tt.maxLength, got, tt.want)
+		}
+	}
+}
+
+func TestVectorApproxEqual(t *testing.T) {
+	tests := []struct {
+		v, o    Vector2D
+		epsilon float64
+		want    bool
+	}{
+		{Vector2D{X: 1, Y: 1}, Vector2D{X: 1, Y: 1}, 0, true},
+		{Vector2D{X: 1, Y: 1}, Vector2D{X: 1 + 1e-12, Y: 1}, Epsilon, true},
+		{Vector2D{X: 1, Y: 1}, Vector2D{X: 1.1, Y: 1}, Epsilon, false},
+		{Vector2D{X: 1, Y: 1}, Vector2D{X: 1, Y: 0.95}, 0.1, true},
+		{Vector2D{X: 1, Y: 1}, Vector2D{X: 1, Y: 0.8}, 0.1, false},
+	}
+	for _, tt := range tests {
+		if got := tt.v.ApproxEqual(tt.o, tt.epsilon); got != tt.want {
+			t.Errorf("%v.ApproxEqual(%v, %v) = %v, want %v", tt.v, tt.o, tt.epsilon, got, tt.want)
+		}
+	}
+}
+
+func TestVectorCell(t *testing.T) {
+	tests := []struct {
+		v              Vector2D
+		cellX, cellY   int
+		roundX, roundY int
+	}{
+		{Vector2D{X: 0, Y: 0}, 0, 0, 0, 0},
+		{Vector2D{X: 2.3, Y: 5.7}, 2, 5, 2, 6},
+		{Vector2D{X: 2.5, Y: 0.49}, 2, 0, 3, 0},
+		{Vector2D{X: -0.2, Y: -1.7}, -1, -2, 0, -2},
+		{Vector2D{X: -2.5, Y: -3}, -3, -3, -3, -3},
+	}
+	for _, tt := range tests {
+		if x, y := tt.v.Cell(); x != tt.cellX || y != tt.cellY {
+			t.Errorf("%v.Cell() = %d, %d, want %d, %d", tt.v, x, y, tt.cellX, tt.cellY)
+		}
+		if x, y := tt.v.RoundCell(); x != tt.roundX || y != tt.roundY {
+			t.Errorf("%v.RoundCell() = %d, %d, want %d, %d", tt.v, x, y, tt.roundX, tt.roundY)
+		}
+	}
+
+	// FromCell returns the top-left corner, which lies in the same cell
+	for _, cell := range [][2]int{{0, 0}, {3, 7}, {-2, -5}} {
+		if x, y := FromCell(cell[0], cell[1]).Cell(); x != cell[0] || y != cell[1] {
+			t.Errorf("FromCell(%d, %d).Cell() = %d, %d", cell[0], cell[1], x, y)
+		}
+	}
+}
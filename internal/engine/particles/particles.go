@@ -0,0 +1,217 @@
+// Package particles implements pooled particle emitters for explosions,
+// sparks, smoke and other short-lived effects. Emitters are driven from a
+// scene's Update and Draw.
+package particles
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"github.com/kuhree/gg/internal/engine/objects"
+	"github.com/kuhree/gg/internal/engine/render"
+)
+
+// Config describes how an emitter spawns and ages its particles
+type Config struct {
+	// Rate is how many particles per second are spawned while emitting, bursts ignore it
+	Rate float64
+
+	// Lifetime in seconds, randomized by +/- LifetimeSpread
+	Lifetime       float64
+	LifetimeSpread float64
+
+	// Speed in cells per second, randomized by +/- SpeedSpread
+	Speed       float64
+	SpeedSpread float64
+
+	// Direction in radians (0 points right, Pi/2 down) and the width of the
+	// cone particles leave in. A Spread of 2*Pi emits in every direction.
+	Direction float64
+	Spread    float64
+
+	// Gravity accelerates particles, Drag slows them down (fraction per second)
+	Gravity objects.Vector2D
+	Drag    float64
+
+	// Glyphs and Colors are ramps over a particle's lifetime, first to last
+	Glyphs []rune
+	Colors []render.Color
+}
+
+// Particle is a single live particle
+type Particle struct {
+	Position objects.Vector2D
+	Velocity objects.Vector2D
+	Age      float64
+	Lifetime float64
+}
+
+// Progress returns how far the particle is through its lifetime, 0 to 1
+func (p *Particle) Progress() float64 {
+	if p.Lifetime <= 0 {
+		return 1
+	}
+	return math.Min(p.Age/p.Lifetime, 1)
+}
+
+// Emitter spawns particles from a fixed-size pool. When the pool is full new
+// particles are dropped, so capacity bounds the cost of an effect.
+type Emitter struct {
+	Config
+
+	// Position particles spawn at when emitting continuously
+	Position objects.Vector2D
+	// Emitting enables continuous spawning at Rate
+	Emitting bool
+
+	// pool holds every particle, the first live entries are alive
+	pool    []Particle
+	live    int
+	pending float64
+}
+
+// NewEmitter creates an emitter holding at most capacity particles
+func NewEmitter(config Config, capacity int) *Emitter {
+	return &Emitter{
+		Config: config,
+		pool:   make([]Particle, capacity),
+	}
+}
+
+// Burst spawns n particles at once at x, y
+func (e *Emitter) Burst(x, y float64, n int) {
+	for i := 0; i < n && e.live < len(e.pool); i++ {
+		e.spawn(x, y)
+	}
+}
+
+// spawn initializes the next free particle in the pool
+func (e *Emitter) spawn(x, y float64) {
+	angle := e.Direction + (rand.Float64()-0.5)*e.Spread
+	speed := e.Speed + (rand.Float64()*2-1)*e.SpeedSpread
+
+	e.pool[e.live] = Particle{
+		Position: objects.Vector2D{X: x, Y: y},
+		Velocity: objects.Vector2D{X: math.Cos(angle) * speed, Y: math.Sin(angle) * speed},
+		Lifetime: math.Max(e.Lifetime+(rand.Float64()*2-1)*e.LifetimeSpread, 0.01),
+	}
+	e.live++
+}
+
+// Update spawns continuous particles and advances live ones, recycling the expired
+func (e *Emitter) Update(dt float64) {
+	if e.Emitting && e.Rate > 0 {
+		e.pending += e.Rate * dt
+		for ; e.pending >= 1; e.pending-- {
+			if e.live < len(e.pool) {
+				e.spawn(e.Position.X, e.Position.Y)
+			}
+		}
+	}
+
+	drag := math.Max(1-e.Drag*dt, 0)
+	for i := 0; i < e.live; {
+		p := &e.pool[i]
+		p.Age += dt
+		if p.Age >= p.Lifetime {
+			// Swap the last live particle into this slot
+			e.live--
+			e.pool[i] = e.pool[e.live]
+			continue
+		}
+
+		p.Velocity.X = (p.Velocity.X + e.Gravity.X*dt) * drag
+		p.Velocity.Y = (p.Velocity.Y + e.Gravity.Y*dt) * drag
+		p.Position.X += p.Velocity.X * dt
+		p.Position.Y += p.Velocity.Y * dt
+		i++
+	}
+}
+
+// Draw draws live particles, picking glyph and color from the ramps
+func (e *Emitter) Draw(r *render.Renderer) {
+	for i := 0; i < e.live; i++ {
+		p := &e.pool[i]
+		glyph, color := e.Look(p)
+		_ = r.DrawChar(glyph, int(math.Round(p.Position.X)), int(math.Round(p.Position.Y)), color)
+	}
+}
+
+// Look returns the glyph and color for a particle at its current age
+func (e *Emitter) Look(p *Particle) (rune, render.Color) {
+	glyph, color := '*', render.ColorWhite
+	progress := p.Progress()
+	if len(e.Glyphs) > 0 {
+		glyph = e.Glyphs[ramp(progress, len(e.Glyphs))]
+	}
+	if len(e.Colors) > 0 {
+		color = e.Colors[ramp(progress, len(e.Colors))]
+	}
+	return glyph, color
+}
+
+// ramp maps progress onto an index into n steps
+func ramp(progress float64, n int) int {
+	return min(int(progress*float64(n)), n-1)
+}
+
+// Alive returns the number of live particles
+func (e *Emitter) Alive() int {
+	return e.live
+}
+
+// Particles returns the live particles, valid until the next Update
+func (e *Emitter) Particles() []Particle {
+	return e.pool[:e.live]
+}
+
+// Reset removes every particle
+func (e *Emitter) Reset() {
+	e.live = 0
+	e.pending = 0
+}
+
+// Explosion is a fast burst in every direction that cools from yellow to smoke
+func Explosion() Config {
+	return Config{
+		Lifetime:       0.6,
+		LifetimeSpread: 0.25,
+		Speed:          12,
+		SpeedSpread:    6,
+		Spread:         2 * math.Pi,
+		Drag:           2.5,
+		Glyphs:         []rune{render.FullBlock, '#', '*', '+', '.'},
+		Colors:         []render.Color{render.ColorBrightWhite, render.ColorBrightYellow, render.ColorYellow, render.ColorRed, render.ColorBrightBlack},
+	}
+}
+
+// Sparks are quick, bright and pulled down by gravity
+func Sparks() Config {
+	return Config{
+		Lifetime:       0.4,
+		LifetimeSpread: 0.15,
+		Speed:          18,
+		SpeedSpread:    8,
+		Direction:      -math.Pi / 2,
+		Spread:         math.Pi,
+		Gravity:        objects.Vector2D{Y: 40},
+		Glyphs:         []rune{'*', '\'', '.'},
+		Colors:         []render.Color{render.ColorBrightWhite, render.ColorBrightYellow, render.ColorYellow},
+	}
+}
+
+// Smoke drifts slowly upwards and fades out
+func Smoke() Config {
+	return Config{
+		Rate:           20,
+		Lifetime:       1.5,
+		LifetimeSpread: 0.5,
+		Speed:          3,
+		SpeedSpread:    1.5,
+		Direction:      -math.Pi / 2,
+		Spread:         math.Pi / 2,
+		Drag:           0.5,
+		Glyphs:         []rune{render.DarkShade, render.MediumShade, render.LightShade, '.'},
+		Colors:         []render.Color{render.ColorWhite, render.ColorBrightBlack},
+	}
+}
@@ -0,0 +1,178 @@
+package particles
+
+import (
+	"testing"
+
+	"github.com/kuhree/gg/internal/engine/objects"
+	"github.com/kuhree/gg/internal/engine/render"
+)
+
+// still spawns particles that stay where they spawn for a second
+func still() Config {
+	return Config{
+		Lifetime: 1,
+		Glyphs:   []rune{'a', 'b'},
+		Colors:   []render.Color{render.ColorRed, render.ColorBlue},
+	}
+}
+
+func TestEmitterCapacity(t *testing.T) {
+	e := NewEmitter(still(), 4)
+
+	e.Burst(0, 0, 3)
+	e.Burst(1, 1, 3)
+	if e.Alive() != 4 {
+		t.Fatalf("Alive after overfilling = %d, want the capacity 4", e.Alive())
+	}
+	// Particles past the capacity are dropped, not spawned over the oldest
+	for i, p := range e.Particles() {
+		want := objects.Vector2D{}
+		if i == 3 {
+			want = objects.Vector2D{X: 1, Y: 1}
+		}
+		if p.Position != want {
+			t.Errorf("particle %d at %v, want %v", i, p.Position, want)
+		}
+	}
+
+	// Expired particles free their slots for new ones
+	e.Update(1)
+	if e.Alive() != 0 {
+		t.Errorf("Alive after the lifetime = %d, want 0", e.Alive())
+	}
+	e.Burst(2, 2, 4)
+	if e.Alive() != 4 {
+		t.Errorf("Alive after recycling = %d, want 4", e.Alive())
+	}
+
+	e.Reset()
+	if e.Alive() != 0 || len(e.Particles()) != 0 {
+		t.Errorf("Alive after Reset = %d, want 0", e.Alive())
+	}
+}
+
+func TestEmitterLifetime(t *testing.T) {
+	e := NewEmitter(still(), 8)
+	e.Burst(0, 0, 2)
+	e.Update(0.5)
+	e.Burst(5, 0, 1)
+
+	e.Update(0.25)
+	if e.Alive() != 3 {
+		t.Fatalf("Alive at 0.75s = %d, want 3", e.Alive())
+	}
+
+	// The first burst expires, the swap keeps the younger particle alive
+	e.Update(0.25)
+	if e.Alive() != 1 || e.Particles()[0].Position.X != 5 {
+		t.Fatalf("live particles at 1s = %v, want the one spawned at 0.5s", e.Particles())
+	}
+	if got := e.Particles()[0].Age; got != 0.5 {
+		t.Errorf("Age = %v, want 0.5", got)
+	}
+
+	e.Update(0.5)
+	if e.Alive() != 0 {
+		t.Errorf("Alive at 1.5s = %d, want 0", e.Alive())
+	}
+}
+
+func TestEmitterRate(t *testing.T) {
+	config := still()
+	config.Rate = 4
+	config.Lifetime = 10
+	e := NewEmitter(config, 3)
+	e.Position = objects.Vector2D{X: 2, Y: 3}
+
+	e.Update(1)
+	if e.Alive() != 0 {
+		t.Errorf("Alive while not emitting = %d, want 0", e.Alive())
+	}
+
+	e.Emitting = true
+	e.Update(0.5)
+	if e.Alive() != 2 || e.Particles()[0].Position != e.Position {
+		t.Errorf("emitted %v, want 2 particles at %v", e.Particles(), e.Position)
+	}
+	// The pool caps continuous emission too
+	e.Update(0.5)
+	if e.Alive() != 3 {
+		t.Errorf("Alive = %d, want the capacity 3", e.Alive())
+	}
+}
+
+func TestEmitterMoves(t *testing.T) {
+	config := still()
+	config.Speed = 4
+	config.Gravity = objects.Vector2D{Y: 8}
+	e := NewEmitter(config, 1)
+
+	e.Burst(0, 0, 1)
+	e.Update(0.5)
+	p := e.Particles()[0]
+	if p.Velocity != (objects.Vector2D{X: 4, Y: 4}) || p.Position != (objects.Vector2D{X: 2, Y: 2}) {
+		t.Errorf("particle at %v moving %v, want at {2 2} moving {4 4}", p.Position, p.Velocity)
+	}
+}
+
+func TestEmitterLook(t *testing.T) {
+	e := NewEmitter(still(), 1)
+	tests := []struct {
+		age   float64
+		glyph rune
+		color render.Color
+	}{
+		{0, 'a', render.ColorRed},
+		{0.49, 'a', render.ColorRed},
+		{0.5, 'b', render.ColorBlue},
+		{1, 'b', render.ColorBlue},
+	}
+	for _, tt := range tests {
+		glyph, color := e.Look(&Particle{Age: tt.age, Lifetime: 1})
+		if glyph != tt.glyph || color != tt.color {
+			t.Errorf("Look at %v = %q, %v, want %q, %v", tt.age, glyph, color, tt.glyph, tt.color)
+		}
+	}
+
+	glyph, color := NewEmitter(Config{}, 1).Look(&Particle{})
+	if glyph != '*' || color != render.ColorWhite {
+		t.Errorf("Look without ramps = %q, %v, want '*', white", glyph, color)
+	}
+}
+
+func TestEmitterDrawRespectsClip(t *testing.T) {
+	e := NewEmitter(still(), 4)
+	e.Burst(1, 0, 1)
+	e.Burst(3, 0, 1)
+	e.Burst(1, 2, 1)
+
+	r := render.NewRenderer(4, 3, render.DefaultPalette)
+	r.SetStrict(true)
+	r.PushClip(render.Rect{Width: 2, Height: 2})
+	e.Draw(r)
+	r.Pop()
+
+	for y := 0; y < 3; y++ {
+		for x := 0; x < 4; x++ {
+			want := ' '
+			if x == 1 && y == 0 {
+				want = 'a'
+			}
+			if got, _ := r.Cell(x, y); got != want {
+				t.Errorf("Cell(%d, %d) = %q, want %q", x, y, got, want)
+			}
+		}
+	}
+
+	// Inside a viewport particles are drawn relative to its origin
+	r.Clear()
+	r.PushViewport(render.Rect{X: 1, Y: 1, Width: 3, Height: 2})
+	e.Draw(r)
+	r.Pop()
+	if got, _ := r.Cell(2, 1); got != 'a' {
+		t.Errorf("Cell(2, 1) in a viewport = %q, want 'a'", got)
+	}
+	if got, _ := r.Cell(2, 3); got != ' ' {
+		t.Errorf("Cell(2, 3) outside the viewport = %q, want ' '", got)
+	}
+}
@@ -0,0 +1,73 @@
+package render
+
+// Animation plays the frames of a sprite using their per-frame durations
+type Animation struct {
+	Sprite *Sprite
+	Loop   bool
+	Speed  float64
+
+	frame   int
+	elapsed float64
+	done    bool
+}
+
+// NewAnimation creates an animation player for the sprite
+func NewAnimation(sprite *Sprite, loop bool) *Animation {
+	return &Animation{
+		Sprite: sprite,
+		Loop:   loop,
+		Speed:  1,
+	}
+}
+
+// Update advances the animation by dt seconds
+func (a *Animation) Update(dt float64) {
+	if a.done || len(a.Sprite.Frames) == 0 {
+		return
+	}
+
+	a.elapsed += dt * a.Speed
+	for {
+		duration := a.Sprite.Frames[a.frame].Duration
+		if duration <= 0 || a.elapsed < duration {
+			return
+		}
+
+		a.elapsed -= duration
+		if a.frame+1 < len(a.Sprite.Frames) {
+			a.frame++
+		} else if a.Loop {
+			a.frame = 0
+		} else {
+			a.done = true
+			return
+		}
+	}
+}
+
+// Frame returns the index of the current frame
+func (a *Animation) Frame() int {
+	return a.frame
+}
+
+// Done reports whether a non-looping animation has played its last frame
+func (a *Animation) Done() bool {
+	return a.done
+}
+
+// Reset rewinds the animation to its first frame
+func (a *Animation) Reset() {
+	a.frame = 0
+	a.elapsed = 0
+	a.done = false
+}
+
+// Draw draws the current frame with its top-left corner at x, y
+func (a *Animation) Draw(r *Renderer, x, y int) error {
+	return r.DrawSprite(a.Sprite, a.frame, x, y)
+}
+
+// DrawColor draws the current frame, filling unset mask cells with color
+func (a *Animation) DrawColor(r *Renderer, x, y int, color Color) error {
+	return r.DrawSpriteColor(a.Sprite, a.frame, x, y, color)
+}
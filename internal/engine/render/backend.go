@@ -0,0 +1,287 @@
+package render
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// Buffer is a frame of cells ready to be presented. Renderer implements it.
+type Buffer interface {
+	Size() (int, int)
+	Cell(x, y int) (rune, Color)
+	Palette() Palette
+}
+
+// Backend presents rendered frames somewhere, e.g. a terminal or memory
+type Backend interface {
+	Present(buf Buffer) error
+}
+
+// ScaleMode controls what the ANSIBackend does when the terminal is smaller
+// than the canvas
+type ScaleMode int
+
+const (
+	// ScaleFit shrinks the canvas to fit using nearest-cell sampling
+	ScaleFit ScaleMode = iota
+	// ScaleNone keeps the canvas at its size and asks for a larger terminal
+	ScaleNone
+)
+
+// ScaleModes maps the names accepted by --scale to their modes
+var ScaleModes = map[string]ScaleMode{
+	"fit":  ScaleFit,
+	"none": ScaleNone,
+}
+
+// defaultScale is the scale mode of renderers created by NewRenderer
+var defaultScale = ScaleFit
+
+// SetScaleMode changes the scale mode of renderers created from now on
+func SetScaleMode(mode ScaleMode) {
+	defaultScale = mode
+}
+
+// ANSIBackend writes frames as ANSI escape sequences. When the terminal is
+// larger than the canvas the frame is centered with a border around it, when
+// it is smaller the frame is scaled down or replaced by a message, see Scale.
+type ANSIBackend struct {
+	// Writer receives the frames, nil uses the output set with SetOutput
+	Writer io.Writer
+	// Scale is used when the terminal is smaller than the canvas
+	Scale ScaleMode
+	// TerminalSize reports the terminal's size. If nil, the size of stdout is
+	// used when writing to the default output, otherwise the canvas size.
+	TerminalSize func() (width, height int, err error)
+}
+
+// NewANSIBackend creates an ANSIBackend writing to w
+func NewANSIBackend(w io.Writer) *ANSIBackend {
+	return &ANSIBackend{Writer: w, Scale: defaultScale}
+}
+
+// Present clears the terminal and writes the whole frame at once
+func (b *ANSIBackend) Present(buf Buffer) error {
+	width, height := buf.Size()
+	palette := buf.Palette()
+	termWidth, termHeight := b.terminalSize(width, height)
+
+	var sb strings.Builder
+	sb.Grow(termWidth * termHeight * 20) // Estimate capacity
+
+	// Clear the console, then move and hide the cursor
+	sb.WriteString("\033[H\033[2J")
+	sb.WriteString("\033[H")    // move to top-left corner
+	sb.WriteString("\033[?25l") // hide the cursor completely
+
+	switch {
+	case termWidth >= width && termHeight >= height:
+		left, top := (termWidth-width)/2, (termHeight-height)/2
+		if left > 0 && top > 0 && left+width < termWidth && top+height < termHeight {
+			writeBorder(&sb, palette, left-1, top-1, width+2, height+2)
+		}
+		writeCells(&sb, buf, palette, left, top, width, height)
+	case b.Scale == ScaleFit && termWidth > 0 && termHeight > 0:
+		scale := min(float64(termWidth)/float64(width), float64(termHeight)/float64(height))
+		scaledWidth, scaledHeight := max(int(float64(width)*scale), 1), max(int(float64(height)*scale), 1)
+		scaled := scaledBuffer{Buffer: buf, width: scaledWidth, height: scaledHeight}
+		writeCells(&sb, scaled, palette, (termWidth-scaledWidth)/2, (termHeight-scaledHeight)/2, scaledWidth, scaledHeight)
+	default:
+		writeEnlarge(&sb, palette, width, height, termWidth, termHeight)
+	}
+
+	w := b.Writer
+	if w == nil {
+		w = output
+	}
+
+	// Write the entire buffer at once
+	if _, err := io.WriteString(w, sb.String()); err != nil {
+		return err
+	}
+	if b.Writer == nil {
+		_ = os.Stdout.Sync()
+	}
+	return nil
+}
+
+// terminalSize returns the size to present into, falling back to the canvas
+// size when it can't be determined
+func (b *ANSIBackend) terminalSize(width, height int) (int, int) {
+	size := b.TerminalSize
+	if size == nil {
+		if b.Writer != nil {
+			return width, height
+		}
+		size = func() (int, int, error) {
+			return term.GetSize(int(os.Stdout.Fd()))
+		}
+	}
+
+	termWidth, termHeight, err := size()
+	if err != nil || termWidth <= 0 || termHeight <= 0 {
+		return width, height
+	}
+	return termWidth, termHeight
+}
+
+// writeCells writes a width x height block of cells with its top-left corner at left, top
+func writeCells(sb *strings.Builder, buf Buffer, palette Palette, left, top, width, height int) {
+	currentColor, colored := ColorBlack, false
+	for y := 0; y < height; y++ {
+		// Use explicit cursor positioning instead of newline
+		fmt.Fprintf(sb, "\033[%d;%dH", top+y+1, left+1)
+		for x := 0; x < width; x++ {
+			char, color := buf.Cell(x, y)
+			if color != currentColor || !colored {
+				currentColor, colored = color, true
+				sb.WriteString(palette.Info(currentColor).ANSI)
+			}
+			if char != wideTail {
+				sb.WriteRune(char)
+			}
+		}
+	}
+}
+
+// writeBorder draws a light box around the letterboxed canvas
+func writeBorder(sb *strings.Builder, palette Palette, left, top, width, height int) {
+	horizontal := strings.Repeat(string(LightHorizontal), width-2)
+	sb.WriteString(palette.Info(ColorBrightBlack).ANSI)
+	fmt.Fprintf(sb, "\033[%d;%dH%c%s%c", top+1, left+1, LightDownAndRight, horizontal, LightDownAndLeft)
+	for y := top + 1; y < top+height-1; y++ {
+		fmt.Fprintf(sb, "\033[%d;%dH%c", y+1, left+1, LightVertical)
+		fmt.Fprintf(sb, "\033[%d;%dH%c", y+1, left+width, LightVertical)
+	}
+	fmt.Fprintf(sb, "\033[%d;%dH%c%s%c", top+height, left+1, LightUpAndRight, horizontal, LightUpAndLeft)
+}
+
+// writeEnlarge replaces the frame with a message asking for a larger terminal
+func writeEnlarge(sb *strings.Builder, palette Palette, width, height, termWidth, termHeight int) {
+	lines := []string{
+		"Please enlarge the terminal",
+		fmt.Sprintf("need %dx%d, have %dx%d", width, height, termWidth, termHeight),
+	}
+
+	sb.WriteString(palette.Info(ColorWhite).ANSI)
+	top := max((termHeight-len(lines))/2, 0)
+	for i, line := range lines {
+		if len(line) > termWidth {
+			line = line[:max(termWidth, 0)]
+		}
+		fmt.Fprintf(sb, "\033[%d;%dH%s", top+i+1, (termWidth-len(line))/2+1, line)
+	}
+}
+
+// scaledBuffer samples a larger buffer down to width x height, picking the
+// nearest cell. Wide characters are dropped since their second half may not
+// survive the sampling.
+type scaledBuffer struct {
+	Buffer
+	width  int
+	height int
+}
+
+func (s scaledBuffer) Size() (int, int) {
+	return s.width, s.height
+}
+
+func (s scaledBuffer) Cell(x, y int) (rune, Color) {
+	width, height := s.Buffer.Size()
+	char, color := s.Buffer.Cell(x*width/s.width, y*height/s.height)
+	if char == wideTail || RuneWidth(char) != 1 {
+		char = ' '
+	}
+	return char, color
+}
+
+// MemoryBackend keeps a copy of the last presented frame, so cells and
+// colors can be inspected without parsing escape codes
+type MemoryBackend struct {
+	width  int
+	height int
+	chars  [][]rune
+	colors [][]Color
+	frames int
+}
+
+// NewMemoryBackend creates an empty MemoryBackend
+func NewMemoryBackend() *MemoryBackend {
+	return &MemoryBackend{}
+}
+
+// Present copies the frame
+func (b *MemoryBackend) Present(buf Buffer) error {
+	width, height := buf.Size()
+	if width != b.width || height != b.height {
+		b.width, b.height = width, height
+		b.chars = make([][]rune, height)
+		b.colors = make([][]Color, height)
+		for y := range b.chars {
+			b.chars[y] = make([]rune, width)
+			b.colors[y] = make([]Color, width)
+		}
+	}
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			b.chars[y][x], b.colors[y][x] = buf.Cell(x, y)
+		}
+	}
+	b.frames++
+	return nil
+}
+
+// Size returns the width and height of the last frame
+func (b *MemoryBackend) Size() (int, int) {
+	return b.width, b.height
+}
+
+// Cell returns the character and color of the last frame at x, y
+func (b *MemoryBackend) Cell(x, y int) (rune, Color) {
+	if x < 0 || x >= b.width || y < 0 || y >= b.height {
+		return ' ', ColorBlack
+	}
+	return b.chars[y][x], b.colors[y][x]
+}
+
+// Frames returns how many frames have been presented
+func (b *MemoryBackend) Frames() int {
+	return b.frames
+}
+
+// Row returns line y of the last frame as text
+func (b *MemoryBackend) Row(y int) string {
+	if y < 0 || y >= b.height {
+		return ""
+	}
+
+	var sb strings.Builder
+	for _, char := range b.chars[y] {
+		if char != wideTail {
+			sb.WriteRune(char)
+		}
+	}
+	return sb.String()
+}
+
+// String returns the last frame as text, one line per row
+func (b *MemoryBackend) String() string {
+	rows := make([]string, b.height)
+	for y := range rows {
+		rows[y] = b.Row(y)
+	}
+	return strings.Join(rows, "\n")
+}
+
+// NullBackend discards every frame, e.g. for benchmarks
+type NullBackend struct{}
+
+// Present does nothing
+func (NullBackend) Present(Buffer) error {
+	return nil
+}
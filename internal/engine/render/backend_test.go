@@ -0,0 +1,83 @@
+package render
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestMemoryBackend(t *testing.T) {
+	r := NewRenderer(6, 2, DefaultPalette)
+	backend := NewMemoryBackend()
+	r.SetBackend(backend)
+
+	_ = r.DrawText("hi 你", 0, 0, ColorRed)
+	_ = r.DrawChar('x', 5, 1, ColorGreen)
+	if err := r.Render(); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := backend.String(), "hi 你 \n     x"; got != want {
+		t.Errorf("frame = %q, want %q", got, want)
+	}
+	if w, h := backend.Size(); w != 6 || h != 2 {
+		t.Errorf("Size = %d, %d, want 6, 2", w, h)
+	}
+	if char, color := backend.Cell(0, 0); char != 'h' || color != ColorRed {
+		t.Errorf("Cell(0, 0) = %q, %v, want 'h', red", char, color)
+	}
+	if char, color := backend.Cell(5, 1); char != 'x' || color != ColorGreen {
+		t.Errorf("Cell(5, 1) = %q, %v, want 'x', green", char, color)
+	}
+	if char, _ := backend.Cell(6, 0); char != ' ' {
+		t.Errorf("Cell outside the frame = %q, want ' '", char)
+	}
+
+	// Later frames replace earlier ones
+	r.Clear()
+	if err := r.Render(); err != nil {
+		t.Fatal(err)
+	}
+	if got := backend.Frames(); got != 2 {
+		t.Errorf("Frames = %d, want 2", got)
+	}
+	if got := backend.Row(0); got != "      " {
+		t.Errorf("Row(0) after Clear = %q, want blank", got)
+	}
+}
+
+func TestANSIBackendColorsOutsidePalette(t *testing.T) {
+	r := NewRenderer(3, 1, DefaultPalette)
+	var out bytes.Buffer
+	r.SetBackend(NewANSIBackend(&out))
+
+	_ = r.DrawChar('a', 0, 0, ColorInherit)
+	_ = r.DrawChar('b', 1, 0, Color(16))
+	_ = r.DrawChar('c', 2, 0, ColorRed)
+	if err := r.Render(); err != nil {
+		t.Fatal(err)
+	}
+
+	white, red := DefaultPalette.Colors[ColorWhite].ANSI, DefaultPalette.Colors[ColorRed].ANSI
+	if got := out.String(); !strings.Contains(got, white+"a") || !strings.Contains(got, white+"b") || !strings.Contains(got, red+"c") {
+		t.Errorf("output = %q, want colors outside the palette drawn in white", got)
+	}
+}
+
+// failingWriter fails every write, like a closed pipe
+type failingWriter struct{}
+
+var errClosed = errors.New("closed")
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errClosed
+}
+
+func TestRenderReturnsBackendError(t *testing.T) {
+	r := NewRenderer(3, 1, DefaultPalette)
+	r.SetBackend(NewANSIBackend(failingWriter{}))
+	if err := r.Render(); !errors.Is(err, errClosed) {
+		t.Errorf("Render = %v, want %v", err, errClosed)
+	}
+}
@@ -0,0 +1,211 @@
+package render
+
+import "strings"
+
+// Banner glyphs are built from the 5x7 bitmap font, with one column of
+// spacing between characters
+const (
+	bannerGlyphWidth  = 5
+	bannerGlyphHeight = 7
+	bannerAdvance     = bannerGlyphWidth + 1
+)
+
+// BannerFont draws large text by mapping blocks of font pixels to cells
+type BannerFont struct {
+	Name string
+	// scaleX and scaleY are the font pixels covered by one cell
+	scaleX int
+	scaleY int
+	// cell returns the glyph for the cell whose top-left pixel is px, py
+	cell func(pixels bannerPixels, px, py int) rune
+}
+
+// BlockFont draws every pixel as a full block, 7 rows tall
+var BlockFont = &BannerFont{
+	Name:   "block",
+	scaleX: 1,
+	scaleY: 1,
+	cell: func(p bannerPixels, x, y int) rune {
+		if p.at(x, y) {
+			return FullBlock
+		}
+		return ' '
+	},
+}
+
+// BoxFont traces the pixels with box drawing lines, 7 rows tall
+var BoxFont = &BannerFont{
+	Name:   "box",
+	scaleX: 1,
+	scaleY: 1,
+	cell: func(p bannerPixels, x, y int) rune {
+		if !p.at(x, y) {
+			return ' '
+		}
+		return boxGlyphs[boxIndex(p.at(x, y-1), p.at(x, y+1), p.at(x-1, y), p.at(x+1, y))]
+	},
+}
+
+// HalfFont packs two rows of pixels into each cell with half blocks, 4 rows tall
+var HalfFont = &BannerFont{
+	Name:   "half",
+	scaleX: 1,
+	scaleY: 2,
+	cell: func(p bannerPixels, x, y int) rune {
+		return halfGlyphs[boxIndex(p.at(x, y), p.at(x, y+1), false, false)>>2]
+	},
+}
+
+// QuadrantFont packs 2x2 pixels into each cell with quadrant blocks, 4 rows
+// tall and the narrowest of the fonts
+var QuadrantFont = &BannerFont{
+	Name:   "quadrant",
+	scaleX: 2,
+	scaleY: 2,
+	cell: func(p bannerPixels, x, y int) rune {
+		return quadrantGlyphs[boxIndex(p.at(x, y), p.at(x+1, y), p.at(x, y+1), p.at(x+1, y+1))]
+	},
+}
+
+// BannerFonts lists the built-in fonts by name
+var BannerFonts = map[string]*BannerFont{
+	BlockFont.Name:    BlockFont,
+	BoxFont.Name:      BoxFont,
+	HalfFont.Name:     HalfFont,
+	QuadrantFont.Name: QuadrantFont,
+}
+
+// boxIndex packs four flags into a 4-bit index, first flag highest
+func boxIndex(a, b, c, d bool) int {
+	index := 0
+	for _, flag := range []bool{a, b, c, d} {
+		index <<= 1
+		if flag {
+			index |= 1
+		}
+	}
+	return index
+}
+
+// boxGlyphs is indexed by which neighbours are set: up, down, left, right
+var boxGlyphs = [16]rune{
+	BlackSquare, LightHorizontal, LightHorizontal, LightHorizontal,
+	LightVertical, LightDownAndRight, LightDownAndLeft, LightHorizontalAndDown,
+	LightVertical, LightUpAndRight, LightUpAndLeft, LightHorizontalAndUp,
+	LightVertical, LightVerticalAndRight, LightVerticalAndLeft, LightCross,
+}
+
+// halfGlyphs is indexed by top, bottom
+var halfGlyphs = [4]rune{' ', LowerHalfBlock, UpperHalfBlock, FullBlock}
+
+// quadrantGlyphs is indexed by top-left, top-right, bottom-left, bottom-right
+var quadrantGlyphs = [16]rune{
+	' ', QuadrantLowerRight, QuadrantLowerLeft, LowerHalfBlock,
+	QuadrantUpperRight, RightHalfBlock, '▞', '▟',
+	QuadrantUpperLeft, '▚', LeftHalfBlock, '▙',
+	UpperHalfBlock, '▜', '▛', FullBlock,
+}
+
+// bannerPixels is a line of text rasterized with the bitmap font
+type bannerPixels []rune
+
+// at reports whether the pixel at x, y is set, false outside the text
+func (p bannerPixels) at(x, y int) bool {
+	if x < 0 || y < 0 || y >= bannerGlyphHeight {
+		return false
+	}
+
+	i, gx := x/bannerAdvance, x%bannerAdvance
+	if i >= len(p) || gx >= bannerGlyphWidth {
+		return false
+	}
+
+	char := p[i]
+	if char < ' ' || char > '~' {
+		char = '?'
+	}
+	return font5x7[char-' '][y]&(1<<(bannerGlyphWidth-1-gx)) != 0
+}
+
+// Measure returns the width and height in cells of text drawn in the font
+func (f *BannerFont) Measure(text string) (int, int) {
+	chars := len([]rune(text))
+	if chars == 0 {
+		return 0, 0
+	}
+	pixels := chars*bannerAdvance - 1
+	return ceilDiv(pixels, f.scaleX), ceilDiv(bannerGlyphHeight, f.scaleY)
+}
+
+// Lines returns text drawn in the font, one string per row
+func (f *BannerFont) Lines(text string) []string {
+	pixels := bannerPixels(text)
+	width, height := f.Measure(text)
+
+	lines := make([]string, height)
+	for y := range lines {
+		var sb strings.Builder
+		for x := 0; x < width; x++ {
+			sb.WriteRune(f.cell(pixels, x*f.scaleX, y*f.scaleY))
+		}
+		lines[y] = sb.String()
+	}
+	return lines
+}
+
+// ceilDiv divides rounding up
+func ceilDiv(a, b int) int {
+	return (a + b - 1) / b
+}
+
+// FitBanner returns the first font that draws text within width cells, or
+// nil if none does
+func FitBanner(text string, width int, fonts ...*BannerFont) *BannerFont {
+	for _, font := range fonts {
+		if w, _ := font.Measure(text); w <= width {
+			return font
+		}
+	}
+	return nil
+}
+
+// Gradient picks colors along a ramp, e.g. down the rows of a banner
+type Gradient []Color
+
+// At returns the color at position i of n, stretching the ramp over n steps
+func (g Gradient) At(i, n int) Color {
+	switch {
+	case len(g) == 0:
+		return ColorWhite
+	case n <= 1:
+		return g[0]
+	}
+	return g[min(i*len(g)/n, len(g)-1)]
+}
+
+// DrawBanner draws text in font with its top-left corner at x, y, coloring
+// rows top to bottom along the gradient. Empty cells are left untouched.
+func (r *Renderer) DrawBanner(text string, x, y int, font *BannerFont, gradient Gradient) error {
+	var err error
+	lines := font.Lines(text)
+	for dy, line := range lines {
+		color := gradient.At(dy, len(lines))
+		dx := 0
+		for _, char := range line {
+			if char != ' ' {
+				if e := r.DrawChar(char, x+dx, y+dy, color); e != nil && err == nil {
+					err = e
+				}
+			}
+			dx++
+		}
+	}
+	return err
+}
+
+// DrawBannerAligned draws text in font on rows starting at y, aligned within
+// width cells from x
+func (r *Renderer) DrawBannerAligned(text string, x, y, width int, align Align, font *BannerFont, gradient Gradient) error {
+	textWidth, _ := font.Measure(text)
+	return r.DrawBanner(text, x+alignOffset(textWidth, width, align), y, font, gradient)
+}
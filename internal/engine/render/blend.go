@@ -0,0 +1,31 @@
+package render
+
+// Blend fills the buffer cell by cell from a or b, taking b wherever pick
+// returns true. Both must be the size of the buffer. Wide characters split
+// between the two are blanked.
+func (r *Renderer) Blend(a, b Buffer, pick func(x, y int) bool) {
+	for y := 0; y < r.height; y++ {
+		row := r.buffer[y]
+		for x := 0; x < r.width; x++ {
+			src := a
+			if pick(x, y) {
+				src = b
+			}
+			row[x], r.colors[y][x] = src.Cell(x, y)
+		}
+
+		for x := 0; x < r.width; x++ {
+			switch {
+			case row[x] == wideTail && (x == 0 || RuneWidth(row[x-1]) != 2):
+				row[x] = ' '
+			case RuneWidth(row[x]) == 2 && (x+1 >= r.width || row[x+1] != wideTail):
+				row[x] = ' '
+			}
+		}
+	}
+}
+
+// Copy fills the buffer from src, which must be the same size
+func (r *Renderer) Copy(src Buffer) {
+	r.Blend(src, src, func(int, int) bool { return false })
+}
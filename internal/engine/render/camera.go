@@ -0,0 +1,158 @@
+package render
+
+import (
+	"math"
+	"math/rand/v2"
+)
+
+// Camera maps world coordinates onto the screen. Its position is the world
+// coordinate drawn at the top-left corner of the viewport.
+type Camera struct {
+	X, Y          float64
+	Width, Height int
+
+	// Bounds limits how far the camera can scroll; an empty rect is unbounded
+	Bounds Rect
+	// Deadzone is the area around the viewport center, in cells, in which a
+	// followed target can move without scrolling the camera
+	Deadzone Rect
+
+	shakeIntensity float64
+	shakeDuration  float64
+	shakeTime      float64
+	shakeX, shakeY int
+}
+
+// NewCamera creates a camera with a viewport of the given size
+func NewCamera(width, height int) *Camera {
+	return &Camera{
+		Width:  width,
+		Height: height,
+	}
+}
+
+// SetDeadzone sets a centered deadzone of the given size
+func (c *Camera) SetDeadzone(width, height int) {
+	c.Deadzone = Rect{
+		X:      (c.Width - width) / 2,
+		Y:      (c.Height - height) / 2,
+		Width:  width,
+		Height: height,
+	}
+}
+
+// CenterOn moves the camera so the world point is in the middle of the viewport
+func (c *Camera) CenterOn(x, y float64) {
+	c.X = x - float64(c.Width)/2
+	c.Y = y - float64(c.Height)/2
+	c.clamp()
+}
+
+// Follow scrolls the camera just enough to keep the world point inside the
+// deadzone. Call it every frame with the target's position.
+func (c *Camera) Follow(x, y float64) {
+	if c.Deadzone.Empty() {
+		c.CenterOn(x, y)
+		return
+	}
+
+	left := c.X + float64(c.Deadzone.X)
+	right := left + float64(c.Deadzone.Width)
+	top := c.Y + float64(c.Deadzone.Y)
+	bottom := top + float64(c.Deadzone.Height)
+
+	switch {
+	case x < left:
+		c.X -= left - x
+	case x >= right:
+		c.X += x - right + 1
+	}
+
+	switch {
+	case y < top:
+		c.Y -= top - y
+	case y >= bottom:
+		c.Y += y - bottom + 1
+	}
+
+	c.clamp()
+}
+
+// clamp keeps the viewport inside the bounds
+func (c *Camera) clamp() {
+	if c.Bounds.Empty() {
+		return
+	}
+
+	c.X = clampAxis(c.X, c.Bounds.X, c.Bounds.Width, c.Width)
+	c.Y = clampAxis(c.Y, c.Bounds.Y, c.Bounds.Height, c.Height)
+}
+
+// clampAxis clamps a viewport position along one axis, centering the world
+// when it is smaller than the viewport
+func clampAxis(pos float64, start, size, view int) float64 {
+	if size <= view {
+		return float64(start) - float64(view-size)/2
+	}
+	return math.Max(float64(start), math.Min(pos, float64(start+size-view)))
+}
+
+// Shake jolts the camera by up to intensity cells, fading out over duration seconds
+func (c *Camera) Shake(intensity, duration float64) {
+	c.shakeIntensity = intensity
+	c.shakeDuration = duration
+	c.shakeTime = duration
+}
+
+// Update advances the camera's shake
+func (c *Camera) Update(dt float64) {
+	if c.shakeTime <= 0 {
+		c.shakeX, c.shakeY = 0, 0
+		return
+	}
+
+	c.shakeTime = math.Max(c.shakeTime-dt, 0)
+	c.shakeX, c.shakeY = shakeOffset(c.shakeIntensity * c.shakeTime / c.shakeDuration)
+}
+
+// shakeOffset returns a random offset of up to amount cells across and half
+// that down, since cells are about twice as tall as wide
+func shakeOffset(amount float64) (int, int) {
+	dx := int(math.Round((rand.Float64()*2 - 1) * amount))
+	dy := int(math.Round((rand.Float64()*2 - 1) * amount / 2))
+	return dx, dy
+}
+
+// offset returns the screen translation applied to world coordinates
+func (c *Camera) offset() (int, int) {
+	return -int(math.Floor(c.X)) + c.shakeX, -int(math.Floor(c.Y)) + c.shakeY
+}
+
+// WorldToScreen converts a world position to a screen cell
+func (c *Camera) WorldToScreen(x, y float64) (int, int) {
+	dx, dy := c.offset()
+	return int(math.Floor(x)) + dx, int(math.Floor(y)) + dy
+}
+
+// ScreenToWorld converts a screen cell to a world position
+func (c *Camera) ScreenToWorld(x, y int) (float64, float64) {
+	dx, dy := c.offset()
+	return float64(x - dx), float64(y - dy)
+}
+
+// View returns the world cells currently covered by the viewport
+func (c *Camera) View() Rect {
+	x, y := c.ScreenToWorld(0, 0)
+	return Rect{X: int(x), Y: int(y), Width: c.Width, Height: c.Height}
+}
+
+// Begin makes subsequent draws on the renderer use world coordinates
+func (c *Camera) Begin(r *Renderer) {
+	dx, dy := c.offset()
+	r.PushOffset(dx, dy)
+}
+
+// End restores screen coordinates after Begin
+func (c *Camera) End(r *Renderer) {
+	r.Pop()
+}
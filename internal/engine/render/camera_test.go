@@ -0,0 +1,119 @@
+package render
+
+import "testing"
+
+func TestCameraFollowDeadzone(t *testing.T) {
+	c := NewCamera(20, 10)
+	c.SetDeadzone(6, 4)
+	if want := (Rect{X: 7, Y: 3, Width: 6, Height: 4}); c.Deadzone != want {
+		t.Fatalf("Deadzone = %+v, want %+v", c.Deadzone, want)
+	}
+
+	// The deadzone covers world x 7..12 and y 3..6 with the camera at 0, 0
+	steps := []struct {
+		x, y   float64
+		cx, cy float64
+	}{
+		{10, 5, 0, 0},
+		{7, 3, 0, 0},
+		{12, 6, 0, 0},
+		// Leaving the deadzone scrolls just enough to bring the point back
+		{13, 5, 1, 0},
+		{2, 5, -5, 0},
+		{-5, 0, -12, -3},
+		{-5, 10, -12, 4},
+	}
+	for _, s := range steps {
+		c.Follow(s.x, s.y)
+		if c.X != s.cx || c.Y != s.cy {
+			t.Errorf("Follow(%v, %v) moved the camera to %v, %v, want %v, %v", s.x, s.y, c.X, c.Y, s.cx, s.cy)
+		}
+	}
+}
+
+func TestCameraFollowWithoutDeadzone(t *testing.T) {
+	c := NewCamera(20, 10)
+	c.Follow(30, 20)
+	if c.X != 20 || c.Y != 15 {
+		t.Errorf("Follow without a deadzone = %v, %v, want it centered at 20, 15", c.X, c.Y)
+	}
+}
+
+func TestCameraBounds(t *testing.T) {
+	tests := []struct {
+		name   string
+		bounds Rect
+		x, y   float64
+		cx, cy float64
+	}{
+		{"unbounded", Rect{}, -100, -100, -110, -105},
+		{"inside", Rect{Width: 50, Height: 30}, 25, 15, 15, 10},
+		{"top-left", Rect{Width: 50, Height: 30}, 0, 0, 0, 0},
+		{"bottom-right", Rect{Width: 50, Height: 30}, 100, 100, 30, 20},
+		{"offset bounds", Rect{X: 10, Y: 5, Width: 50, Height: 30}, 0, 0, 10, 5},
+		// Worlds smaller than the viewport are centered in it
+		{"narrow world", Rect{Width: 10, Height: 30}, 100, 100, -5, 20},
+	}
+	for _, tt := range tests {
+		c := NewCamera(20, 10)
+		c.Bounds = tt.bounds
+		c.CenterOn(tt.x, tt.y)
+		if c.X != tt.cx || c.Y != tt.cy {
+			t.Errorf("%s: CenterOn(%v, %v) = %v, %v, want %v, %v", tt.name, tt.x, tt.y, c.X, c.Y, tt.cx, tt.cy)
+		}
+	}
+
+	// Following stays inside the bounds as well
+	c := NewCamera(20, 10)
+	c.Bounds = Rect{Width: 50, Height: 30}
+	c.SetDeadzone(6, 4)
+	for x := 0.0; x <= 60; x++ {
+		c.Follow(x, 35)
+	}
+	if c.X != 30 || c.Y != 20 {
+		t.Errorf("following past the corner = %v, %v, want 30, 20", c.X, c.Y)
+	}
+}
+
+func TestCameraTransform(t *testing.T) {
+	c := NewCamera(10, 5)
+	c.X, c.Y = 3.5, -2
+
+	if x, y := c.WorldToScreen(5, 2); x != 2 || y != 4 {
+		t.Errorf("WorldToScreen(5, 2) = %d, %d, want 2, 4", x, y)
+	}
+	if x, y := c.ScreenToWorld(2, 4); x != 5 || y != 2 {
+		t.Errorf("ScreenToWorld(2, 4) = %v, %v, want 5, 2", x, y)
+	}
+	if want := (Rect{X: 3, Y: -2, Width: 10, Height: 5}); c.View() != want {
+		t.Errorf("View = %+v, want %+v", c.View(), want)
+	}
+
+	r := NewRenderer(10, 5, DefaultPalette)
+	c.Begin(r)
+	_ = r.DrawChar('a', 5, 2, ColorWhite)
+	c.End(r)
+	if got, _ := r.Cell(2, 4); got != 'a' {
+		t.Errorf("Cell(2, 4) = %q, want 'a' drawn through the camera", got)
+	}
+	if got := r.Clip(); got != (Rect{Width: 10, Height: 5}) {
+		t.Errorf("Clip after End = %+v, want the whole buffer", got)
+	}
+}
+
+func TestCameraShake(t *testing.T) {
+	c := NewCamera(10, 5)
+	c.Shake(2, 1)
+	for i := 0; i < 9; i++ {
+		c.Update(0.1)
+		if c.shakeX < -2 || c.shakeX > 2 || c.shakeY < -1 || c.shakeY > 1 {
+			t.Fatalf("shake offset %d, %d, want at most 2, 1", c.shakeX, c.shakeY)
+		}
+	}
+
+	c.Update(0.5)
+	c.Update(0.1)
+	if x, y := c.WorldToScreen(0, 0); x != 0 || y != 0 {
+		t.Errorf("WorldToScreen after the shake = %d, %d, want 0, 0", x, y)
+	}
+}
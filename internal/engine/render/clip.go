@@ -0,0 +1,88 @@
+package render
+
+// Rect is an axis-aligned rectangle in buffer cells
+type Rect struct {
+	X, Y          int
+	Width, Height int
+}
+
+// Contains reports whether the cell at x, y lies within the rectangle
+func (r Rect) Contains(x, y int) bool {
+	return x >= r.X && x < r.X+r.Width && y >= r.Y && y < r.Y+r.Height
+}
+
+// Empty reports whether the rectangle covers no cells
+func (r Rect) Empty() bool {
+	return r.Width <= 0 || r.Height <= 0
+}
+
+// Intersect returns the overlap of two rectangles
+func (r Rect) Intersect(o Rect) Rect {
+	x0, y0 := max(r.X, o.X), max(r.Y, o.Y)
+	x1, y1 := min(r.X+r.Width, o.X+o.Width), min(r.Y+r.Height, o.Y+o.Height)
+	if x1 <= x0 || y1 <= y0 {
+		return Rect{X: x0, Y: y0}
+	}
+	return Rect{X: x0, Y: y0, Width: x1 - x0, Height: y1 - y0}
+}
+
+// viewport is an entry on the renderer's clip stack. clip is in absolute
+// buffer cells, origin is where local (0, 0) lands in the buffer.
+type viewport struct {
+	clip             Rect
+	originX, originY int
+}
+
+// viewport returns the active viewport, or the whole buffer if none was pushed
+func (r *Renderer) viewport() viewport {
+	if n := len(r.clips); n > 0 {
+		return r.clips[n-1]
+	}
+	return viewport{clip: Rect{Width: r.width, Height: r.height}}
+}
+
+// PushClip restricts drawing to rect, given in the current viewport's
+// coordinates. Coordinates are not translated; use PushViewport for that.
+func (r *Renderer) PushClip(rect Rect) {
+	vp := r.viewport()
+	rect.X += vp.originX
+	rect.Y += vp.originY
+	r.clips = append(r.clips, viewport{
+		clip:    vp.clip.Intersect(rect),
+		originX: vp.originX,
+		originY: vp.originY,
+	})
+}
+
+// PushViewport restricts drawing to rect and moves the origin to its top-left
+// corner, so (0, 0) in subsequent draws maps to (rect.X, rect.Y).
+func (r *Renderer) PushViewport(rect Rect) {
+	r.PushClip(rect)
+	vp := &r.clips[len(r.clips)-1]
+	vp.originX += rect.X
+	vp.originY += rect.Y
+}
+
+// PushOffset translates subsequent draws by dx, dy without changing the clip
+func (r *Renderer) PushOffset(dx, dy int) {
+	vp := r.viewport()
+	vp.originX += dx
+	vp.originY += dy
+	r.clips = append(r.clips, vp)
+}
+
+// Pop removes the most recently pushed clip, viewport or offset
+func (r *Renderer) Pop() {
+	if n := len(r.clips); n > 0 {
+		r.clips = r.clips[:n-1]
+	}
+}
+
+// Clip returns the active clip rectangle in the current viewport's coordinates
+func (r *Renderer) Clip() Rect {
+	vp := r.viewport()
+	clip := vp.clip
+	clip.X -= vp.originX
+	clip.Y -= vp.originY
+	return clip
+}
@@ -0,0 +1,251 @@
+package render
+
+import (
+	"math"
+)
+
+// Effect is a post-processing pass over the whole buffer. Strength runs from
+// 0 (no effect) to 1 (full effect) and is usually driven by an Envelope.
+type Effect interface {
+	Apply(r *Renderer, strength float64)
+}
+
+// Envelope shapes an effect's strength over time: it ramps up over Attack,
+// stays at full strength for Hold and ramps down over Release, all in seconds
+type Envelope struct {
+	Attack  float64
+	Hold    float64
+	Release float64
+}
+
+// Duration returns the total length of the envelope
+func (e Envelope) Duration() float64 {
+	return e.Attack + e.Hold + e.Release
+}
+
+// At returns the strength at t seconds into the envelope
+func (e Envelope) At(t float64) float64 {
+	switch {
+	case t < 0:
+		return 0
+	case t < e.Attack:
+		return t / e.Attack
+	case t < e.Attack+e.Hold:
+		return 1
+	case t < e.Duration():
+		return 1 - (t-e.Attack-e.Hold)/e.Release
+	default:
+		return 0
+	}
+}
+
+// activeEffect is an effect playing through its envelope
+type activeEffect struct {
+	effect   Effect
+	envelope Envelope
+	elapsed  float64
+}
+
+// Effects plays timed effects. Update it from the game's Update and Apply it
+// after the scene has drawn, right before Render.
+type Effects struct {
+	active []activeEffect
+}
+
+// NewEffects creates an empty effect list
+func NewEffects() *Effects {
+	return &Effects{}
+}
+
+// Add starts an effect with the given envelope
+func (e *Effects) Add(effect Effect, envelope Envelope) {
+	e.active = append(e.active, activeEffect{effect: effect, envelope: envelope})
+}
+
+// Update advances every effect, dropping finished ones
+func (e *Effects) Update(dt float64) {
+	active := e.active[:0]
+	for _, a := range e.active {
+		a.elapsed += dt
+		if a.elapsed < a.envelope.Duration() {
+			active = append(active, a)
+		}
+	}
+	e.active = active
+}
+
+// Apply runs every effect over the renderer's buffer, in the order they were added
+func (e *Effects) Apply(r *Renderer) {
+	for _, a := range e.active {
+		a.effect.Apply(r, a.envelope.At(a.elapsed))
+	}
+}
+
+// Active reports whether any effect is playing
+func (e *Effects) Active() bool {
+	return len(e.active) > 0
+}
+
+// Clear stops every effect
+func (e *Effects) Clear() {
+	e.active = e.active[:0]
+}
+
+// Shake offsets the whole frame, HUD included, by up to Intensity cells in a
+// random direction. It jolts the same way as Camera.Shake, which only moves
+// the world.
+type Shake struct {
+	Intensity float64
+}
+
+func (s Shake) Apply(r *Renderer, strength float64) {
+	r.shift(shakeOffset(s.Intensity * strength))
+}
+
+// shift moves the buffer contents by dx, dy, blanking uncovered cells. It
+// draws into a scratch buffer that it swaps with the frame, so shaking
+// doesn't allocate every frame.
+func (r *Renderer) shift(dx, dy int) {
+	if (dx == 0 && dy == 0) || r.width == 0 {
+		return
+	}
+
+	if len(r.scratch) != r.height {
+		r.scratch = make([][]rune, r.height)
+		r.scratchColors = make([][]Color, r.height)
+		for y := range r.scratch {
+			r.scratch[y] = make([]rune, r.width)
+			r.scratchColors[y] = make([]Color, r.width)
+		}
+	}
+
+	buffer, colors := r.scratch, r.scratchColors
+	for y := range buffer {
+		for x := range buffer[y] {
+			buffer[y][x] = ' '
+			colors[y][x] = ColorBlack
+
+			sx, sy := x-dx, y-dy
+			if sx >= 0 && sx < r.width && sy >= 0 && sy < r.height {
+				buffer[y][x] = r.buffer[sy][sx]
+				colors[y][x] = r.colors[sy][sx]
+			}
+		}
+
+		// Don't leave half of a wide character behind at either edge
+		if buffer[y][0] == wideTail {
+			buffer[y][0] = ' '
+		}
+		if last := r.width - 1; RuneWidth(buffer[y][last]) == 2 {
+			buffer[y][last] = ' '
+		}
+	}
+	r.buffer, r.scratch = buffer, r.buffer
+	r.colors, r.scratchColors = colors, r.colors
+}
+
+// flashShades fill empty cells as a flash gets stronger
+var flashShades = []rune{' ', LightShade, MediumShade, DarkShade, FullBlock}
+
+// Flash floods the screen with Color, filling empty cells with ever denser shades
+type Flash struct {
+	Color Color
+}
+
+func (f Flash) Apply(r *Renderer, strength float64) {
+	shade := flashShades[min(int(strength*float64(len(flashShades))), len(flashShades)-1)]
+	if shade == ' ' {
+		return
+	}
+
+	for y := 0; y < r.height; y++ {
+		for x := 0; x < r.width; x++ {
+			if r.buffer[y][x] == ' ' || strength >= 1 {
+				r.buffer[y][x] = shade
+			}
+			r.colors[y][x] = f.Color
+		}
+	}
+}
+
+// dimmer steps a color one shade towards black
+func dimmer(c Color) Color {
+	switch {
+	case c == ColorBlack || c == ColorBrightBlack:
+		return ColorBlack
+	case c > ColorBrightBlack:
+		return c - ColorBrightBlack
+	default:
+		return ColorBrightBlack
+	}
+}
+
+// fadeSteps is how many dimmer steps take any color to black
+const fadeSteps = 3
+
+// Fade darkens the frame towards black, hiding it entirely at full strength.
+// Use an envelope with Attack to fade out and one with Release to fade in.
+type Fade struct{}
+
+func (Fade) Apply(r *Renderer, strength float64) {
+	r.dim(int(math.Round(strength * fadeSteps)))
+}
+
+// Dim darkens everything by one shade, e.g. the scene behind a modal
+type Dim struct{}
+
+func (Dim) Apply(r *Renderer, strength float64) {
+	if strength > 0 {
+		r.dim(1)
+	}
+}
+
+// dim darkens every cell by the given number of steps
+func (r *Renderer) dim(steps int) {
+	if steps <= 0 {
+		return
+	}
+
+	for y := 0; y < r.height; y++ {
+		for x := 0; x < r.width; x++ {
+			color := r.colors[y][x]
+			for i := 0; i < steps; i++ {
+				color = dimmer(color)
+			}
+
+			r.colors[y][x] = color
+			if color == ColorBlack {
+				r.buffer[y][x] = ' '
+			}
+		}
+	}
+}
+
+// Tint recolors a growing share of the non-empty cells with Color, in a
+// fixed dither pattern so the tint doesn't flicker
+type Tint struct {
+	Color Color
+}
+
+func (t Tint) Apply(r *Renderer, strength float64) {
+	for y := 0; y < r.height; y++ {
+		for x := 0; x < r.width; x++ {
+			if r.buffer[y][x] != ' ' && dither(x, y) < strength {
+				r.colors[y][x] = t.Color
+			}
+		}
+	}
+}
+
+// bayer4 is a 4x4 ordered dither matrix
+var bayer4 = [4][4]float64{
+	{0, 8, 2, 10},
+	{12, 4, 14, 6},
+	{3, 11, 1, 9},
+	{15, 7, 13, 5},
+}
+
+// dither returns a threshold in [0, 1) for the cell at x, y
+func dither(x, y int) float64 {
+	return bayer4[y&3][x&3] / 16
+}
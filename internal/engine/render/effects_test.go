@@ -0,0 +1,60 @@
+package render
+
+import "testing"
+
+func TestShift(t *testing.T) {
+	tests := []struct {
+		dx, dy int
+		want   []string
+	}{
+		{0, 0, []string{"abc", "def"}},
+		{1, 0, []string{" ab", " de"}},
+		{-1, 0, []string{"bc ", "ef "}},
+		{0, 1, []string{"   ", "abc"}},
+		{-1, -1, []string{"ef ", "   "}},
+		{5, 0, []string{"   ", "   "}},
+	}
+	for _, tt := range tests {
+		r := NewRenderer(3, 2, DefaultPalette)
+		_ = r.DrawText("abc", 0, 0, ColorRed)
+		_ = r.DrawText("def", 0, 1, ColorRed)
+		r.shift(tt.dx, tt.dy)
+		for y, want := range tt.want {
+			if got := string(r.buffer[y]); got != want {
+				t.Errorf("shift(%d, %d) row %d = %q, want %q", tt.dx, tt.dy, y, got, want)
+			}
+		}
+	}
+}
+
+func TestShiftReusesScratch(t *testing.T) {
+	r := NewRenderer(20, 10, DefaultPalette)
+	r.shift(1, 1)
+	if allocs := testing.AllocsPerRun(10, func() { r.shift(1, -1) }); allocs != 0 {
+		t.Errorf("shift allocated %v times per frame, want 0", allocs)
+	}
+}
+
+func TestShiftDropsSplitWideRune(t *testing.T) {
+	r := NewRenderer(4, 1, DefaultPalette)
+	_ = r.DrawText("你b", 0, 0, ColorRed)
+	r.shift(-1, 0)
+	if got, _ := r.Cell(0, 0); got != ' ' {
+		t.Errorf("cell 0 after splitting a wide rune = %q, want ' '", got)
+	}
+}
+
+func TestShiftDropsWideRuneAtRightEdge(t *testing.T) {
+	r := NewRenderer(4, 1, DefaultPalette)
+	_ = r.DrawText("a你", 1, 0, ColorRed)
+	r.shift(1, 0)
+
+	backend := NewMemoryBackend()
+	r.SetBackend(backend)
+	if err := r.Render(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := backend.Row(0), "  a "; got != want {
+		t.Errorf("row after shifting a wide rune's tail off the edge = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,171 @@
+package render
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/kuhree/gg/internal/utils"
+)
+
+// ExportFormat names a file format the buffer can be exported to
+type ExportFormat string
+
+const (
+	FormatPNG  ExportFormat = "png"
+	FormatSVG  ExportFormat = "svg"
+	FormatHTML ExportFormat = "html"
+	FormatANSI ExportFormat = "ansi"
+)
+
+// ExportFormats lists every supported export format
+var ExportFormats = []ExportFormat{FormatPNG, FormatSVG, FormatHTML, FormatANSI}
+
+// Export writes the current buffer to w in the given format
+func (r *Renderer) Export(w io.Writer, format ExportFormat) error {
+	switch format {
+	case FormatPNG:
+		return r.ExportPNG(w)
+	case FormatSVG:
+		return r.ExportSVG(w)
+	case FormatHTML:
+		return r.ExportHTML(w)
+	case FormatANSI:
+		return r.ExportANSI(w)
+	default:
+		return fmt.Errorf("unknown export format %q", format)
+	}
+}
+
+// SaveScreenshot exports the current buffer into dir, once per format, and
+// returns the written paths. With no formats given, every format is saved.
+func (r *Renderer) SaveScreenshot(dir string, formats ...ExportFormat) ([]string, error) {
+	if len(formats) == 0 {
+		formats = ExportFormats
+	}
+
+	base := filepath.Join(dir, "screenshot-"+time.Now().Format("20060102-150405.000"))
+	paths := make([]string, 0, len(formats))
+	for _, format := range formats {
+		path := base + "." + string(format)
+		if err := r.saveExport(path, format); err != nil {
+			return paths, err
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+func (r *Renderer) saveExport(path string, format ExportFormat) error {
+	if err := utils.EnsureDir(path); err != nil {
+		return err
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := r.Export(file, format); err != nil {
+		file.Close()
+		return err
+	}
+	// The file isn't complete until it's closed
+	return file.Close()
+}
+
+// colorInfo returns the palette entry for a color, falling back to white
+func (r *Renderer) colorInfo(color Color) ColorInfo {
+	return r.palette.Info(color)
+}
+
+// hexColor formats a palette color as a CSS hex color
+func (r *Renderer) hexColor(color Color) string {
+	rgb := r.colorInfo(color).RGB
+	return fmt.Sprintf("#%02x%02x%02x", rgb[0], rgb[1], rgb[2])
+}
+
+// runs calls fn for each stretch of same-colored cells in a row, skipping
+// the right halves of wide characters. Empty rows have no runs.
+func (r *Renderer) runs(y int, fn func(x int, text string, color Color)) {
+	if r.width == 0 {
+		return
+	}
+
+	start, color := 0, r.colors[y][0]
+	var sb strings.Builder
+	for x := 0; x <= r.width; x++ {
+		if x == r.width || r.colors[y][x] != color {
+			fn(start, sb.String(), color)
+			if x == r.width {
+				return
+			}
+			start, color = x, r.colors[y][x]
+			sb.Reset()
+		}
+		if r.buffer[y][x] != wideTail {
+			sb.WriteRune(r.buffer[y][x])
+		}
+	}
+}
+
+// ExportANSI writes the buffer as plain lines of text with ANSI color codes
+func (r *Renderer) ExportANSI(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	for y := 0; y < r.height; y++ {
+		r.runs(y, func(_ int, text string, color Color) {
+			bw.WriteString(r.colorInfo(color).ANSI)
+			bw.WriteString(text)
+		})
+		bw.WriteString("\033[0m\n")
+	}
+	return bw.Flush()
+}
+
+// ExportHTML writes the buffer as a self-contained HTML page with a colored <pre>
+func (r *Renderer) ExportHTML(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	bw.WriteString("<style>body{margin:0;background:#000}pre{margin:0;padding:1em;font-family:monospace;line-height:1.2;background:#000}</style>\n")
+	bw.WriteString("</head>\n<body>\n<pre>")
+	for y := 0; y < r.height; y++ {
+		r.runs(y, func(_ int, text string, color Color) {
+			fmt.Fprintf(bw, "<span style=\"color:%s\">%s</span>", r.hexColor(color), html.EscapeString(text))
+		})
+		bw.WriteString("\n")
+	}
+	bw.WriteString("</pre>\n</body>\n</html>\n")
+	return bw.Flush()
+}
+
+// SVG cell size in user units
+const (
+	svgCellWidth  = 10
+	svgCellHeight = 20
+)
+
+// ExportSVG writes the buffer as an SVG image with one text run per colored span
+func (r *Renderer) ExportSVG(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	width, height := r.width*svgCellWidth, r.height*svgCellHeight
+	fmt.Fprintf(bw, "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%d\" height=\"%d\" viewBox=\"0 0 %d %d\">\n", width, height, width, height)
+	fmt.Fprintf(bw, "<rect width=\"100%%\" height=\"100%%\" fill=\"#000\"/>\n")
+	fmt.Fprintf(bw, "<g font-family=\"monospace\" font-size=\"%d\" xml:space=\"preserve\">\n", svgCellHeight*4/5)
+	for y := 0; y < r.height; y++ {
+		r.runs(y, func(x int, text string, color Color) {
+			if strings.TrimSpace(text) == "" {
+				return
+			}
+			fmt.Fprintf(bw, "<text x=\"%d\" y=\"%d\" fill=\"%s\" textLength=\"%d\">%s</text>\n",
+				x*svgCellWidth, (y+1)*svgCellHeight-svgCellHeight/4, r.hexColor(color),
+				StringWidth(text)*svgCellWidth, html.EscapeString(text))
+		})
+	}
+	bw.WriteString("</g>\n</svg>\n")
+	return bw.Flush()
+}
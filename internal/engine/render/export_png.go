@@ -0,0 +1,189 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+)
+
+// PNG cells are drawn on a 6x10 pixel grid and scaled up
+const (
+	pngCellWidth  = 6
+	pngCellHeight = 10
+	pngScale      = 2
+)
+
+// quadrants maps quadrant block characters to a bitmask of filled quarters:
+// 1 upper left, 2 upper right, 4 lower left, 8 lower right
+var quadrants = map[rune]int{
+	QuadrantUpperLeft:  1,
+	QuadrantUpperRight: 2,
+	QuadrantLowerLeft:  4,
+	QuadrantLowerRight: 8,
+	UpperHalfBlock:     1 | 2,
+	LowerHalfBlock:     4 | 8,
+	LeftHalfBlock:      1 | 4,
+	RightHalfBlock:     2 | 8,
+	'▚':                1 | 8,
+	'▞':                2 | 4,
+	'▛':                1 | 2 | 4,
+	'▜':                1 | 2 | 8,
+	'▙':                1 | 4 | 8,
+	'▟':                2 | 4 | 8,
+	FullBlock:          1 | 2 | 4 | 8,
+}
+
+// boxArms maps box drawing characters to the arms they draw from the cell
+// center: 1 up, 2 right, 4 down, 8 left
+var boxArms = map[rune]int{
+	LightHorizontal:        2 | 8,
+	LightVertical:          1 | 4,
+	'┃':                    1 | 4,
+	'━':                    2 | 8,
+	LightDownAndRight:      2 | 4,
+	LightDownAndLeft:       4 | 8,
+	LightUpAndRight:        1 | 2,
+	LightUpAndLeft:         1 | 8,
+	LightVerticalAndRight:  1 | 2 | 4,
+	LightVerticalAndLeft:   1 | 4 | 8,
+	LightHorizontalAndDown: 2 | 4 | 8,
+	LightHorizontalAndUp:   1 | 2 | 8,
+	LightCross:             1 | 2 | 4 | 8,
+}
+
+// glyphPixel reports whether pixel px, py of a cell grid of the given width is
+// lit for char. Known block, box and shape characters are drawn
+// geometrically, printable ASCII uses the bitmap font and anything else is
+// drawn as a hollow box.
+func glyphPixel(char rune, px, py, width int) bool {
+	const cx, cy = pngCellWidth / 2, pngCellHeight / 2
+
+	if mask, ok := quadrants[char]; ok {
+		quarter := 1
+		if px >= width/2 {
+			quarter <<= 1
+		}
+		if py >= cy {
+			quarter <<= 2
+		}
+		return mask&quarter != 0
+	}
+
+	if arms, ok := boxArms[char]; ok {
+		onV := px == cx
+		onH := py == cy
+		return (arms&1 != 0 && onV && py <= cy) ||
+			(arms&2 != 0 && onH && px >= cx) ||
+			(arms&4 != 0 && onV && py >= cy) ||
+			(arms&8 != 0 && onH && px <= cx)
+	}
+
+	dx, dy := float64(px)-float64(cx)+0.5, (float64(py)-float64(cy)+0.5)/2
+	dist := math.Hypot(dx, dy)
+
+	switch char {
+	case ' ', wideTail:
+		return false
+	case LightShade:
+		return (px+py*2)%4 == 0
+	case MediumShade:
+		return (px+py)%2 == 0
+	case DarkShade:
+		return (px+py*2)%4 != 0
+	case BlackCircle:
+		return dist <= 2.5
+	case WhiteCircle:
+		return dist <= 2.5 && dist >= 1.5
+	case BlackDot:
+		return dist <= 1.2
+	case BlackSquare:
+		return px >= 1 && px <= 4 && py >= 3 && py <= 7
+	case WhiteSquare:
+		return px >= 1 && px <= 4 && py >= 3 && py <= 7 && (px == 1 || px == 4 || py == 3 || py == 7)
+	case BlackTriangle, WhiteTriangle:
+		row := py - 3
+		inside := row >= 0 && row <= 4 && math.Abs(float64(px)-2.5) <= float64(row)/2+0.5
+		if char == WhiteTriangle {
+			return inside && (row == 4 || math.Abs(float64(px)-2.5) > float64(row)/2-0.5)
+		}
+		return inside
+	case LeftArrow, RightArrow:
+		if py == cy {
+			return px >= 0 && px <= 5
+		}
+		tip := 0
+		if char == RightArrow {
+			tip = 5
+		}
+		return abs(py-cy) <= 2 && abs(px-tip) == abs(py-cy)
+	case UpArrow, DownArrow:
+		if px == cx {
+			return py >= 2 && py <= 8
+		}
+		tip := 2
+		if char == DownArrow {
+			tip = 8
+		}
+		return abs(px-cx) <= 2 && abs(py-tip) == abs(px-cx)
+	}
+
+	if char > ' ' && char <= '~' {
+		gx, gy := px, py-1
+		if gx < 0 || gx >= 5 || gy < 0 || gy >= 7 {
+			return false
+		}
+		return font5x7[char-' '][gy]&(1<<(4-gx)) != 0
+	}
+
+	// Unknown glyph, draw a hollow box
+	return px >= 1 && px < width-1 && py >= 1 && py <= 8 && (px == 1 || px == width-2 || py == 1 || py == 8)
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// ExportPNG writes the buffer as a PNG image using the built-in bitmap font
+func (r *Renderer) ExportPNG(w io.Writer) error {
+	img := image.NewRGBA(image.Rect(0, 0, r.width*pngCellWidth*pngScale, r.height*pngCellHeight*pngScale))
+	background := color.RGBA{A: 255}
+	for i := 0; i < len(img.Pix); i += 4 {
+		img.Pix[i+3] = background.A
+	}
+
+	for y := 0; y < r.height; y++ {
+		for x := 0; x < r.width; x++ {
+			char := r.buffer[y][x]
+			if char == wideTail || char == ' ' {
+				continue
+			}
+
+			rgb := r.colorInfo(r.colors[y][x]).RGB
+			fg := color.RGBA{R: rgb[0], G: rgb[1], B: rgb[2], A: 255}
+
+			width := pngCellWidth * RuneWidth(char)
+			for py := 0; py < pngCellHeight; py++ {
+				for px := 0; px < width; px++ {
+					if !glyphPixel(char, px, py, width) {
+						continue
+					}
+
+					ox := (x*pngCellWidth + px) * pngScale
+					oy := (y*pngCellHeight + py) * pngScale
+					for sy := 0; sy < pngScale; sy++ {
+						for sx := 0; sx < pngScale; sx++ {
+							img.SetRGBA(ox+sx, oy+sy, fg)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return png.Encode(w, img)
+}
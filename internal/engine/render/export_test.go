@@ -0,0 +1,155 @@
+package render
+
+import (
+	"bytes"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// exportScene draws colored text, a wide rune and markup that needs escaping
+func exportScene() *Renderer {
+	r := NewRenderer(4, 2, DefaultPalette)
+	_ = r.DrawText("ab", 0, 0, ColorRed)
+	_ = r.DrawText("<你", 0, 1, ColorGreen)
+	return r
+}
+
+func TestExportANSI(t *testing.T) {
+	var out bytes.Buffer
+	if err := exportScene().Export(&out, FormatANSI); err != nil {
+		t.Fatal(err)
+	}
+
+	ansi := func(c Color) string { return DefaultPalette.Colors[c].ANSI }
+	want := ansi(ColorRed) + "ab" + ansi(ColorBlack) + "  \033[0m\n" +
+		ansi(ColorGreen) + "<你" + ansi(ColorBlack) + " \033[0m\n"
+	if got := out.String(); got != want {
+		t.Errorf("ANSI export = %q, want %q", got, want)
+	}
+}
+
+func TestExportHTML(t *testing.T) {
+	var out bytes.Buffer
+	if err := exportScene().Export(&out, FormatHTML); err != nil {
+		t.Fatal(err)
+	}
+
+	r := exportScene()
+	got := out.String()
+	for _, want := range []string{
+		`<span style="color:` + r.hexColor(ColorRed) + `">ab</span>`,
+		`<span style="color:` + r.hexColor(ColorGreen) + `">&lt;你</span>`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("HTML export is missing %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestExportSVG(t *testing.T) {
+	var out bytes.Buffer
+	if err := exportScene().Export(&out, FormatSVG); err != nil {
+		t.Fatal(err)
+	}
+
+	got := out.String()
+	if !strings.HasPrefix(got, `<svg xmlns="http://www.w3.org/2000/svg" width="40" height="40"`) {
+		t.Errorf("SVG export starts %q, want a 40x40 image", got[:min(len(got), 80)])
+	}
+	// Blank runs are left out, wide runes count twice towards the length
+	if n := strings.Count(got, "<text "); n != 2 {
+		t.Errorf("SVG export has %d text runs, want 2:\n%s", n, got)
+	}
+	if !strings.Contains(got, `textLength="30">&lt;你</text>`) {
+		t.Errorf("SVG export is missing the wide run:\n%s", got)
+	}
+}
+
+func TestExportPNG(t *testing.T) {
+	var out bytes.Buffer
+	if err := exportScene().Export(&out, FormatPNG); err != nil {
+		t.Fatal(err)
+	}
+
+	img, err := png.Decode(&out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bounds := img.Bounds()
+	if w, h := bounds.Dx(), bounds.Dy(); w != 4*pngCellWidth*pngScale || h != 2*pngCellHeight*pngScale {
+		t.Errorf("PNG size = %dx%d, want %dx%d", w, h, 4*pngCellWidth*pngScale, 2*pngCellHeight*pngScale)
+	}
+
+	red := DefaultPalette.Colors[ColorRed].RGB
+	found := false
+	for y := 0; y < pngCellHeight*pngScale && !found; y++ {
+		for x := 0; x < pngCellWidth*pngScale && !found; x++ {
+			r, g, b, _ := img.At(x, y).RGBA()
+			found = uint8(r>>8) == red[0] && uint8(g>>8) == red[1] && uint8(b>>8) == red[2]
+		}
+	}
+	if !found {
+		t.Error("PNG export has no red pixels in the first cell")
+	}
+}
+
+func TestExportUnknownFormat(t *testing.T) {
+	if err := exportScene().Export(&bytes.Buffer{}, "gif"); err == nil {
+		t.Error("Export to an unknown format succeeded")
+	}
+}
+
+func TestExportZeroWidth(t *testing.T) {
+	r := NewRenderer(0, 2, DefaultPalette)
+	for _, format := range []ExportFormat{FormatANSI, FormatHTML, FormatSVG} {
+		if err := r.Export(&bytes.Buffer{}, format); err != nil {
+			t.Errorf("Export(%s) of an empty buffer = %v", format, err)
+		}
+	}
+
+	var out bytes.Buffer
+	_ = r.Export(&out, FormatANSI)
+	if got, want := out.String(), "\033[0m\n\033[0m\n"; got != want {
+		t.Errorf("ANSI export of an empty buffer = %q, want %q", got, want)
+	}
+}
+
+func TestSaveScreenshot(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "shots")
+	r := exportScene()
+
+	paths, err := r.SaveScreenshot(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) != len(ExportFormats) {
+		t.Fatalf("saved %v, want one file per format", paths)
+	}
+	for i, path := range paths {
+		if ext := filepath.Ext(path); ext != "."+string(ExportFormats[i]) {
+			t.Errorf("path %s has extension %s, want .%s", path, ext, ExportFormats[i])
+		}
+	}
+
+	saved, err := os.ReadFile(paths[3])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want bytes.Buffer
+	_ = r.ExportANSI(&want)
+	if !bytes.Equal(saved, want.Bytes()) {
+		t.Errorf("saved ANSI = %q, want %q", saved, want.Bytes())
+	}
+
+	// A file in the way of the directory fails the save
+	blocked := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(blocked, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if paths, err := r.SaveScreenshot(filepath.Join(blocked, "shots"), FormatANSI); err == nil || len(paths) != 0 {
+		t.Errorf("SaveScreenshot under a file = %v, %v, want an error", paths, err)
+	}
+}
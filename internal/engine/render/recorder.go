@@ -0,0 +1,90 @@
+package render
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// Recorder tees renderer output into an asciicast v2 file.
+// See https://docs.asciinema.org/manual/asciicast/v2/
+type Recorder struct {
+	mu    sync.Mutex
+	out   io.Writer
+	cast  io.Writer
+	enc   *json.Encoder
+	clock func() float64
+	err   error
+}
+
+// castHeader is the first line of an asciicast v2 file
+type castHeader struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+// NewRecorder writes the asciicast header for a terminal of the given size to
+// cast and returns a Recorder that forwards writes to out while recording them.
+// Until SetClock is called, events are stamped with wall-clock time.
+func NewRecorder(cast io.Writer, out io.Writer, width, height int) (*Recorder, error) {
+	start := time.Now()
+	rec := &Recorder{
+		out:  out,
+		cast: cast,
+		enc:  json.NewEncoder(cast),
+		clock: func() float64 {
+			return time.Since(start).Seconds()
+		},
+	}
+
+	header := castHeader{
+		Version:   2,
+		Width:     width,
+		Height:    height,
+		Timestamp: start.Unix(),
+		Env: map[string]string{
+			"TERM":  os.Getenv("TERM"),
+			"SHELL": os.Getenv("SHELL"),
+		},
+	}
+	if err := rec.enc.Encode(header); err != nil {
+		return nil, err
+	}
+
+	return rec, nil
+}
+
+// SetClock sets the source of event timestamps, in seconds since the start
+// of the recording. Use the game loop's clock so time-scaled runs play back
+// at game speed.
+func (rec *Recorder) SetClock(clock func() float64) {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	rec.clock = clock
+}
+
+// Write forwards p to the output and records it as an output event. Failing
+// to record does not fail the write; the first error is kept for Err.
+func (rec *Recorder) Write(p []byte) (int, error) {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	if rec.err == nil {
+		event := []any{rec.clock(), "o", string(p)}
+		rec.err = rec.enc.Encode(event)
+	}
+
+	return rec.out.Write(p)
+}
+
+// Err returns the first error encountered while recording
+func (rec *Recorder) Err() error {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	return rec.err
+}
@@ -2,9 +2,8 @@ package render
 
 import (
 	"errors"
-	"fmt"
+	"io"
 	"os"
-	"strings"
 )
 
 const (
@@ -73,37 +72,60 @@ const (
 )
 
 type ColorInfo struct {
-	Name string
-	ANSI string
-	// RGB [3]int
+	Name string   `json:"name"`
+	ANSI string   `json:"ansi"`
+	RGB  [3]uint8 `json:"rgb"`
 	// RGBA [4]int
 }
 
 type Palette struct {
-	Colors []ColorInfo
+	Colors []ColorInfo `json:"colors"`
+}
+
+// Info returns the palette entry for a color, falling back to white for
+// colors outside the palette
+func (p Palette) Info(color Color) ColorInfo {
+	if color >= 0 && int(color) < len(p.Colors) {
+		return p.Colors[color]
+	}
+	if int(ColorWhite) < len(p.Colors) {
+		return p.Colors[ColorWhite]
+	}
+	return DefaultPalette.Colors[ColorWhite]
 }
 
 var DefaultPalette = Palette{
 	Colors: []ColorInfo{
-		ColorBlack:         {"black", "\033[30m"},
-		ColorRed:           {"red", "\033[31m"},
-		ColorGreen:         {"green", "\033[32m"},
-		ColorYellow:        {"yellow", "\033[33m"},
-		ColorBlue:          {"blue", "\033[34m"},
-		ColorMagenta:       {"magenta", "\033[35m"},
-		ColorCyan:          {"cyan", "\033[36m"},
-		ColorWhite:         {"white", "\033[37m"},
-		ColorBrightBlack:   {"bright_black", "\033[90m"},
-		ColorBrightRed:     {"bright_red", "\033[91m"},
-		ColorBrightGreen:   {"bright_green", "\033[92m"},
-		ColorBrightYellow:  {"bright_yellow", "\033[93m"},
-		ColorBrightBlue:    {"bright_blue", "\033[94m"},
-		ColorBrightMagenta: {"bright_magenta", "\033[95m"},
-		ColorBrightCyan:    {"bright_cyan", "\033[96m"},
-		ColorBrightWhite:   {"bright_white", "\033[97m"},
+		ColorBlack:         {"black", "\033[30m", [3]uint8{0, 0, 0}},
+		ColorRed:           {"red", "\033[31m", [3]uint8{205, 0, 0}},
+		ColorGreen:         {"green", "\033[32m", [3]uint8{0, 205, 0}},
+		ColorYellow:        {"yellow", "\033[33m", [3]uint8{205, 205, 0}},
+		ColorBlue:          {"blue", "\033[34m", [3]uint8{0, 0, 238}},
+		ColorMagenta:       {"magenta", "\033[35m", [3]uint8{205, 0, 205}},
+		ColorCyan:          {"cyan", "\033[36m", [3]uint8{0, 205, 205}},
+		ColorWhite:         {"white", "\033[37m", [3]uint8{229, 229, 229}},
+		ColorBrightBlack:   {"bright_black", "\033[90m", [3]uint8{127, 127, 127}},
+		ColorBrightRed:     {"bright_red", "\033[91m", [3]uint8{255, 0, 0}},
+		ColorBrightGreen:   {"bright_green", "\033[92m", [3]uint8{0, 255, 0}},
+		ColorBrightYellow:  {"bright_yellow", "\033[93m", [3]uint8{255, 255, 0}},
+		ColorBrightBlue:    {"bright_blue", "\033[94m", [3]uint8{92, 92, 255}},
+		ColorBrightMagenta: {"bright_magenta", "\033[95m", [3]uint8{255, 0, 255}},
+		ColorBrightCyan:    {"bright_cyan", "\033[96m", [3]uint8{0, 255, 255}},
+		ColorBrightWhite:   {"bright_white", "\033[97m", [3]uint8{255, 255, 255}},
 	},
 }
 
+// output receives everything written by Render, see SetOutput
+var output io.Writer = os.Stdout
+
+// SetOutput redirects the output of every renderer, e.g. to tee frames into a Recorder
+func SetOutput(w io.Writer) {
+	output = w
+}
+
+// ErrOutOfBounds is returned by strict renderers when drawing outside the clip rectangle
+var ErrOutOfBounds = errors.New("drawing outside buffer bounds")
+
 // Renderer handles the ASCII rendering for the game
 type Renderer struct {
 	width   int
@@ -111,9 +133,20 @@ type Renderer struct {
 	buffer  [][]rune
 	colors  [][]Color
 	palette Palette
+	theme   *Theme
+	backend Backend
+
+	// clips holds the pushed viewports, the last entry is the active one
+	clips  []viewport
+	strict bool
+
+	// scratch is the spare frame effects like Shake draw into
+	scratch       [][]rune
+	scratchColors [][]Color
 }
 
-// NewRenderer creates a new Renderer with the specified dimensions
+// NewRenderer creates a new Renderer with the specified dimensions. It uses
+// the current theme, whose palette replaces pal if the theme sets one.
 func NewRenderer(width, height int, pal Palette) *Renderer {
 	buffer := make([][]rune, height)
 	colors := make([][]Color, height)
@@ -126,22 +159,83 @@ func NewRenderer(width, height int, pal Palette) *Renderer {
 		}
 	}
 
+	theme := CurrentTheme()
+	if theme.palette != nil {
+		pal = *theme.palette
+	}
+
 	return &Renderer{
 		width:   width,
 		height:  height,
 		buffer:  buffer,
 		colors:  colors,
 		palette: pal,
+		theme:   theme,
+		backend: &ANSIBackend{Scale: defaultScale},
 	}
 }
 
+// SetStrict enables or disables strict bounds checking. When strict, draws
+// outside the active clip rectangle return ErrOutOfBounds instead of being
+// silently clipped. Useful while debugging layouts.
+func (r *Renderer) SetStrict(strict bool) {
+	r.strict = strict
+}
+
+// SetBackend changes where Render presents the buffer
+func (r *Renderer) SetBackend(backend Backend) {
+	r.backend = backend
+}
+
 // Size returns the width and height of the canvas
 func (r *Renderer) Size() (int, int) {
 	return r.width, r.height
 }
 
-// Clear clears the render buffer
+// Cell returns the character and color at the specified buffer position.
+// The right half of a wide character is reported as a zero rune.
+func (r *Renderer) Cell(x, y int) (rune, Color) {
+	if x < 0 || x >= r.width || y < 0 || y >= r.height {
+		return ' ', ColorBlack
+	}
+	return r.buffer[y][x], r.colors[y][x]
+}
+
+// Theme returns the theme the renderer was created with
+func (r *Renderer) Theme() *Theme {
+	return r.theme
+}
+
+// Color returns the theme's color for a semantic role, see RolePlayer etc.
+func (r *Renderer) Color(role string) Color {
+	return r.theme.Color(role)
+}
+
+// ColorOr returns the theme's color for role, or fallback if the theme
+// doesn't assign one
+func (r *Renderer) ColorOr(role string, fallback Color) Color {
+	return r.theme.ColorOr(role, fallback)
+}
+
+// ColorAs returns the theme's color for a game role, see Theme.ColorAs
+func (r *Renderer) ColorAs(role, semantic string, fallback Color) Color {
+	return r.theme.ColorAs(role, semantic, fallback)
+}
+
+// RampColor returns color, or one color for the whole ramp on semantic
+// themes, see Theme.RampColor
+func (r *Renderer) RampColor(role string, color Color) Color {
+	return r.theme.RampColor(role, color)
+}
+
+// Palette returns the palette used to present colors
+func (r *Renderer) Palette() Palette {
+	return r.palette
+}
+
+// Clear clears the render buffer and resets the clip stack
 func (r *Renderer) Clear() {
+	r.clips = r.clips[:0]
 	for y := 0; y < r.height; y++ {
 		for x := 0; x < r.width; x++ {
 			r.buffer[y][x] = ' '
@@ -155,69 +249,101 @@ func (r *Renderer) DrawPixel(x, y int, color Color) error {
 	return r.DrawChar(FullBlock, x, y, color)
 }
 
-// DrawChar draws a character at the specified position
+// DrawChar draws a character at the specified position, relative to the
+// active viewport. Characters outside the clip rectangle are dropped. Wide
+// characters occupy two cells and are dropped if the second cell is clipped.
 func (r *Renderer) DrawChar(char rune, x, y int, color Color) error {
-	if x < 0 || x >= r.width || y < 0 || y >= r.height {
-		return errors.New("drawing outside buffer bounds")
+	width := RuneWidth(char)
+	if width == 0 {
+		return nil
+	}
+
+	vp := r.viewport()
+	x, y = x+vp.originX, y+vp.originY
+	if !vp.clip.Contains(x, y) || (width == 2 && !vp.clip.Contains(x+1, y)) {
+		if r.strict {
+			return ErrOutOfBounds
+		}
+		return nil
+	}
+
+	r.setCell(x, y, char, color)
+	if width == 2 {
+		r.setCell(x+1, y, wideTail, color)
 	}
-	r.buffer[y][x] = char
-	r.colors[y][x] = color
 	return nil
 }
 
-// DrawText draws a string of text at the specified position
+// setCell writes a single buffer cell, blanking any wide character it splits
+func (r *Renderer) setCell(x, y int, char rune, color Color) {
+	row := r.buffer[y]
+	if row[x] == wideTail && x > 0 && char != wideTail {
+		row[x-1] = ' '
+	}
+	if x+1 < r.width && row[x+1] == wideTail {
+		row[x+1] = ' '
+	}
+
+	row[x] = char
+	r.colors[y][x] = color
+}
+
+// DrawText draws a string of text at the specified position. Columns advance
+// by each rune's display width, so wide characters take two cells.
 func (r *Renderer) DrawText(text string, x, y int, color Color) error {
-	for i, char := range text {
-		if err := r.DrawChar(char, x+i, y, color); err != nil {
-			return err
+	var err error
+	for _, char := range text {
+		if e := r.DrawChar(char, x, y, color); e != nil && err == nil {
+			err = e
 		}
+		x += RuneWidth(char)
 	}
-	return nil
+	return err
 }
 
 // DrawRect draws a rectangle with the specified dimensions
 func (r *Renderer) DrawRect(x, y, width, height int, char rune, color Color) error {
+	var err error
 	for dy := 0; dy < height; dy++ {
 		for dx := 0; dx < width; dx++ {
-			if err := r.DrawChar(char, x+dx, y+dy, color); err != nil {
-				return err
+			if e := r.DrawChar(char, x+dx, y+dy, color); e != nil && err == nil {
+				err = e
 			}
 		}
 	}
-	return nil
+	return err
 }
 
-// Render outputs the current buffer to the console
-func (r *Renderer) Render() {
-	fmt.Print("\033[H\033[2J") // Clear the console
-
-	var sb strings.Builder
-	sb.Grow(r.width * r.height * 20) // Estimate capacity
-
-	// Move and hide the cursor
-	sb.WriteString("\033[H")    // move to top-left corner
-	sb.WriteString("\033[?25l") // hide the cursor completely
+// Render presents the current buffer through the renderer's backend,
+// returning the backend's error, e.g. when the terminal is gone
+func (r *Renderer) Render() error {
+	return r.backend.Present(r)
+}
 
-	currentColor := ColorBlack
-	for y := 0; y < r.height; y++ {
-		for x := 0; x < r.width; x++ {
-			if r.colors[y][x] != currentColor {
-				currentColor = r.colors[y][x]
-				sb.WriteString(r.palette.Colors[currentColor].ANSI)
-			}
-			sb.WriteRune(r.buffer[y][x])
-		}
-		// Use explicit cursor positioning instead of newline
-		sb.WriteString(fmt.Sprintf("\033[%d;1H", y+2))
-	}
+// Terminal control sequences
+const (
+	seqAltScreenOn  = "\033[?1049h"
+	seqAltScreenOff = "\033[?1049l"
+	seqWrapOff      = "\033[?7l"
+	seqWrapOn       = "\033[?7h"
+	seqCursorHide   = "\033[?25l"
+	seqCursorShow   = "\033[?25h"
+	seqResetColors  = "\033[0m"
+	seqClearScreen  = "\033[H\033[2J"
+)
 
-	// Write the entire buffer at once
-	os.Stdout.Write([]byte(sb.String()))
-	os.Stdout.Sync()
+func ShowCursor() {
+	_, _ = io.WriteString(output, seqCursorShow)
+}
 
-	fmt.Print(sb.String())
+// EnterScreen switches to the alternate screen buffer, so the game doesn't
+// draw over the scrollback, and turns off line wrap and the cursor
+func EnterScreen() {
+	_, _ = io.WriteString(output, seqAltScreenOn+seqWrapOff+seqCursorHide+seqClearScreen)
 }
 
-func ShowCursor() {
-	fmt.Print("\033[?25h")
+// LeaveScreen undoes EnterScreen: it resets colors, line wrap and the cursor
+// and returns to the main screen with its scrollback intact
+func LeaveScreen() {
+	_, _ = io.WriteString(output, seqResetColors+seqWrapOn+seqCursorShow+seqAltScreenOff)
 }
@@ -0,0 +1,192 @@
+package render
+
+import (
+	"errors"
+	"testing"
+)
+
+// drawn lists the cells of r that aren't blank, as "x,y" keys
+func drawn(r *Renderer) map[[2]int]rune {
+	cells := make(map[[2]int]rune)
+	for y := 0; y < r.height; y++ {
+		for x := 0; x < r.width; x++ {
+			if char, _ := r.Cell(x, y); char != ' ' {
+				cells[[2]int{x, y}] = char
+			}
+		}
+	}
+	return cells
+}
+
+func TestClipStack(t *testing.T) {
+	tests := []struct {
+		name string
+		push func(r *Renderer)
+		clip Rect
+		// cells filled by DrawRect(-10, -10, 30, 30), in buffer coordinates
+		filled Rect
+	}{
+		{
+			name:   "none",
+			push:   func(r *Renderer) {},
+			clip:   Rect{Width: 8, Height: 6},
+			filled: Rect{Width: 8, Height: 6},
+		},
+		{
+			name:   "clip",
+			push:   func(r *Renderer) { r.PushClip(Rect{X: 2, Y: 1, Width: 3, Height: 2}) },
+			clip:   Rect{X: 2, Y: 1, Width: 3, Height: 2},
+			filled: Rect{X: 2, Y: 1, Width: 3, Height: 2},
+		},
+		{
+			name:   "clip past the edge",
+			push:   func(r *Renderer) { r.PushClip(Rect{X: 6, Y: 4, Width: 10, Height: 10}) },
+			clip:   Rect{X: 6, Y: 4, Width: 2, Height: 2},
+			filled: Rect{X: 6, Y: 4, Width: 2, Height: 2},
+		},
+		{
+			name:   "viewport",
+			push:   func(r *Renderer) { r.PushViewport(Rect{X: 2, Y: 1, Width: 3, Height: 2}) },
+			clip:   Rect{Width: 3, Height: 2},
+			filled: Rect{X: 2, Y: 1, Width: 3, Height: 2},
+		},
+		{
+			name:   "offset",
+			push:   func(r *Renderer) { r.PushOffset(3, 2) },
+			clip:   Rect{X: -3, Y: -2, Width: 8, Height: 6},
+			filled: Rect{Width: 8, Height: 6},
+		},
+		{
+			name: "nested clips intersect",
+			push: func(r *Renderer) {
+				r.PushClip(Rect{X: 1, Y: 1, Width: 4, Height: 4})
+				r.PushClip(Rect{X: 3, Y: 0, Width: 4, Height: 3})
+			},
+			clip:   Rect{X: 3, Y: 1, Width: 2, Height: 2},
+			filled: Rect{X: 3, Y: 1, Width: 2, Height: 2},
+		},
+		{
+			name: "clip inside a viewport",
+			push: func(r *Renderer) {
+				r.PushViewport(Rect{X: 2, Y: 1, Width: 4, Height: 4})
+				r.PushClip(Rect{X: 1, Y: 1, Width: 10, Height: 1})
+			},
+			clip:   Rect{X: 1, Y: 1, Width: 3, Height: 1},
+			filled: Rect{X: 3, Y: 2, Width: 3, Height: 1},
+		},
+		{
+			name: "nested viewports",
+			push: func(r *Renderer) {
+				r.PushViewport(Rect{X: 1, Y: 1, Width: 6, Height: 4})
+				r.PushViewport(Rect{X: 2, Y: 1, Width: 2, Height: 2})
+			},
+			clip:   Rect{Width: 2, Height: 2},
+			filled: Rect{X: 3, Y: 2, Width: 2, Height: 2},
+		},
+		{
+			name: "disjoint clips",
+			push: func(r *Renderer) {
+				r.PushClip(Rect{Width: 2, Height: 2})
+				r.PushClip(Rect{X: 4, Y: 4, Width: 2, Height: 2})
+			},
+			clip: Rect{X: 4, Y: 4},
+		},
+	}
+	for _, tt := range tests {
+		r := NewRenderer(8, 6, DefaultPalette)
+		tt.push(r)
+		if got := r.Clip(); got != tt.clip {
+			t.Errorf("%s: Clip = %+v, want %+v", tt.name, got, tt.clip)
+		}
+
+		_ = r.DrawRect(-10, -10, 30, 30, '#', ColorWhite)
+		got := drawn(r)
+		for y := 0; y < 6; y++ {
+			for x := 0; x < 8; x++ {
+				if _, ok := got[[2]int{x, y}]; ok != tt.filled.Contains(x, y) {
+					t.Errorf("%s: cell %d, %d drawn = %v, want %v", tt.name, x, y, ok, !ok)
+				}
+			}
+		}
+	}
+}
+
+func TestPushOffsetTranslates(t *testing.T) {
+	r := NewRenderer(8, 6, DefaultPalette)
+	r.PushViewport(Rect{X: 1, Y: 1, Width: 4, Height: 4})
+	r.PushOffset(2, 1)
+	_ = r.DrawChar('a', 0, 0, ColorWhite)
+	_ = r.DrawChar('b', 2, 0, ColorWhite) // clipped by the viewport
+	r.Pop()
+	_ = r.DrawChar('c', 0, 0, ColorWhite)
+
+	want := map[[2]int]rune{{3, 2}: 'a', {1, 1}: 'c'}
+	if got := drawn(r); len(got) != len(want) || got[[2]int{3, 2}] != 'a' || got[[2]int{1, 1}] != 'c' {
+		t.Errorf("drawn %v, want %v", got, want)
+	}
+}
+
+func TestPopUnderflow(t *testing.T) {
+	r := NewRenderer(4, 2, DefaultPalette)
+	r.PushClip(Rect{Width: 1, Height: 1})
+	r.Pop()
+	r.Pop()
+	r.Pop()
+	if got := r.Clip(); got != (Rect{Width: 4, Height: 2}) {
+		t.Errorf("Clip after popping too often = %+v, want the whole buffer", got)
+	}
+
+	// Pushing still works after an underflow
+	r.PushOffset(1, 1)
+	_ = r.DrawChar('a', 0, 0, ColorWhite)
+	if got, _ := r.Cell(1, 1); got != 'a' {
+		t.Errorf("Cell(1, 1) = %q, want 'a'", got)
+	}
+
+	// Clear drops whatever is left on the stack
+	r.PushClip(Rect{Width: 1, Height: 1})
+	r.Clear()
+	if got := r.Clip(); got != (Rect{Width: 4, Height: 2}) {
+		t.Errorf("Clip after Clear = %+v, want the whole buffer", got)
+	}
+}
+
+func TestStrictClipping(t *testing.T) {
+	r := NewRenderer(4, 2, DefaultPalette)
+	r.PushClip(Rect{X: 1, Width: 2, Height: 2})
+
+	if err := r.DrawChar('a', 0, 0, ColorWhite); err != nil {
+		t.Errorf("DrawChar outside the clip = %v, want nil when not strict", err)
+	}
+
+	r.SetStrict(true)
+	tests := []struct {
+		char rune
+		x, y int
+		want error
+	}{
+		{'a', 1, 0, nil},
+		{'a', 0, 0, ErrOutOfBounds},
+		{'a', 3, 1, ErrOutOfBounds},
+		{'a', 1, 2, ErrOutOfBounds},
+		// A wide rune needs both cells inside the clip
+		{'你', 1, 1, nil},
+		{'你', 2, 1, ErrOutOfBounds},
+	}
+	for _, tt := range tests {
+		if err := r.DrawChar(tt.char, tt.x, tt.y, ColorWhite); !errors.Is(err, tt.want) {
+			t.Errorf("DrawChar(%q, %d, %d) = %v, want %v", tt.char, tt.x, tt.y, err, tt.want)
+		}
+	}
+	if got, _ := r.Cell(0, 0); got != ' ' {
+		t.Errorf("strict draw outside the clip wrote %q", got)
+	}
+
+	// Drawing text keeps going past the clip and reports the first error
+	if err := r.DrawText("abcd", 0, 0, ColorWhite); !errors.Is(err, ErrOutOfBounds) {
+		t.Errorf("DrawText across the clip = %v, want %v", err, ErrOutOfBounds)
+	}
+	if got, _ := r.Cell(2, 0); got != 'c' {
+		t.Errorf("Cell(2, 0) = %q, want 'c'", got)
+	}
+}
@@ -0,0 +1,218 @@
+package render
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// ColorInherit marks sprite cells that take the color passed at draw time
+const ColorInherit Color = -1
+
+// SpriteFrame is a single image of a sprite
+type SpriteFrame struct {
+	Glyphs   [][]rune
+	Colors   [][]Color
+	Duration float64
+}
+
+// Sprite is a multi-frame block of glyphs loaded from the text sprite format.
+//
+// A sprite file is made of directive lines starting with '@' followed by
+// glyph or color rows:
+//
+//	@transparent .
+//	@color 7
+//	@frame 0.4
+//	.▄▄.
+//	▀██▀
+//	@colors
+//	.11.
+//	1771
+//
+// "@frame [seconds]" starts a new frame and is followed by its glyph rows.
+// "@colors" switches to the frame's color mask, where hex digits 0-f pick a
+// palette color and any other character inherits the sprite color. Each mask
+// character colors the glyph at the same position in its row, so wide glyphs
+// take a single mask character.
+// "@transparent" sets the glyph that is skipped when drawing (default ' ')
+// and "@color" sets the default color. Lines starting with "@#" are comments.
+type Sprite struct {
+	Name        string
+	Width       int
+	Height      int
+	Transparent rune
+	Color       Color
+	Frames      []SpriteFrame
+}
+
+// ParseSprite reads a sprite in the text sprite format
+func ParseSprite(name string, r io.Reader) (*Sprite, error) {
+	sprite := &Sprite{
+		Name:        name,
+		Transparent: ' ',
+		Color:       ColorWhite,
+	}
+
+	var frame *SpriteFrame
+	inColors := false
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimRight(scanner.Text(), "\r")
+
+		if strings.HasPrefix(text, "@") {
+			directive, arg, _ := strings.Cut(strings.TrimPrefix(text, "@"), " ")
+			switch {
+			case strings.HasPrefix(directive, "#"):
+			case directive == "transparent":
+				runes := []rune(arg)
+				if len(runes) != 1 {
+					return nil, fmt.Errorf("%s:%d: @transparent expects a single character", name, line)
+				}
+				sprite.Transparent = runes[0]
+			case directive == "color":
+				// 4 bits keeps the color inside the 16 color palette
+				color, err := strconv.ParseUint(strings.TrimSpace(arg), 16, 4)
+				if err != nil {
+					return nil, fmt.Errorf("%s:%d: invalid color %q: %w", name, line, arg, err)
+				}
+				sprite.Color = Color(color)
+			case directive == "frame":
+				duration := 0.0
+				if arg = strings.TrimSpace(arg); arg != "" {
+					d, err := strconv.ParseFloat(arg, 64)
+					if err != nil {
+						return nil, fmt.Errorf("%s:%d: invalid frame duration %q: %w", name, line, arg, err)
+					}
+					duration = d
+				}
+				sprite.Frames = append(sprite.Frames, SpriteFrame{Duration: duration})
+				frame = &sprite.Frames[len(sprite.Frames)-1]
+				inColors = false
+			case directive == "colors":
+				if frame == nil {
+					return nil, fmt.Errorf("%s:%d: @colors before @frame", name, line)
+				}
+				inColors = true
+			default:
+				return nil, fmt.Errorf("%s:%d: unknown directive @%s", name, line, directive)
+			}
+			continue
+		}
+
+		if frame == nil {
+			if strings.TrimSpace(text) == "" {
+				continue
+			}
+			return nil, fmt.Errorf("%s:%d: glyph row before @frame", name, line)
+		}
+
+		if inColors {
+			frame.Colors = append(frame.Colors, parseColorRow(text))
+		} else {
+			frame.Glyphs = append(frame.Glyphs, []rune(text))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(sprite.Frames) == 0 {
+		return nil, fmt.Errorf("%s: sprite has no frames", name)
+	}
+
+	for _, f := range sprite.Frames {
+		sprite.Height = max(sprite.Height, len(f.Glyphs))
+		for _, row := range f.Glyphs {
+			sprite.Width = max(sprite.Width, StringWidth(string(row)))
+		}
+	}
+
+	return sprite, nil
+}
+
+// parseColorRow converts a color mask row into palette colors
+func parseColorRow(text string) []Color {
+	row := make([]Color, 0, len(text))
+	for _, char := range text {
+		color, err := strconv.ParseUint(string(char), 16, 8)
+		if err != nil {
+			row = append(row, ColorInherit)
+			continue
+		}
+		row = append(row, Color(color))
+	}
+	return row
+}
+
+// LoadSprite reads a single sprite file from fsys. The sprite is named after
+// the file without its extension.
+func LoadSprite(fsys fs.FS, filename string) (*Sprite, error) {
+	file, err := fsys.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	name := strings.TrimSuffix(path.Base(filename), path.Ext(filename))
+	return ParseSprite(name, file)
+}
+
+// LoadSprites reads every sprite matching pattern from fsys, keyed by name
+func LoadSprites(fsys fs.FS, pattern string) (map[string]*Sprite, error) {
+	matches, err := fs.Glob(fsys, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	sprites := make(map[string]*Sprite, len(matches))
+	for _, match := range matches {
+		sprite, err := LoadSprite(fsys, match)
+		if err != nil {
+			return nil, err
+		}
+		sprites[sprite.Name] = sprite
+	}
+	return sprites, nil
+}
+
+// DrawSprite draws a frame of the sprite with its top-left corner at x, y
+func (r *Renderer) DrawSprite(sprite *Sprite, frame, x, y int) error {
+	return r.DrawSpriteColor(sprite, frame, x, y, sprite.Color)
+}
+
+// DrawSpriteColor draws a frame of the sprite, using color for every cell the
+// color mask leaves unset. Frames wrap around, so any frame number is valid.
+func (r *Renderer) DrawSpriteColor(sprite *Sprite, frame, x, y int, color Color) error {
+	n := len(sprite.Frames)
+	if n == 0 {
+		return nil
+	}
+
+	f := sprite.Frames[((frame%n)+n)%n]
+	var err error
+	for dy, row := range f.Glyphs {
+		column := 0
+		for i, char := range row {
+			dx := column
+			column += RuneWidth(char)
+			if char == sprite.Transparent {
+				continue
+			}
+
+			c := color
+			if dy < len(f.Colors) && i < len(f.Colors[dy]) && f.Colors[dy][i] != ColorInherit {
+				c = f.Colors[dy][i]
+			}
+
+			if e := r.DrawChar(char, x+dx, y+dy, c); e != nil && err == nil {
+				err = e
+			}
+		}
+	}
+	return err
+}
@@ -0,0 +1,85 @@
+package render
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseSpriteColor(t *testing.T) {
+	tests := []struct {
+		arg     string
+		want    Color
+		wantErr bool
+	}{
+		{"0", ColorBlack, false},
+		{"7", ColorWhite, false},
+		{"f", ColorBrightWhite, false},
+		{"10", 0, true},
+		{"ff", 0, true},
+		{"-1", 0, true},
+		{"x", 0, true},
+	}
+	for _, tt := range tests {
+		sprite, err := ParseSprite("test", strings.NewReader("@color "+tt.arg+"\n@frame\n#\n"))
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("@color %s: want an error, got color %v", tt.arg, sprite.Color)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("@color %s: %v", tt.arg, err)
+			continue
+		}
+		if sprite.Color != tt.want {
+			t.Errorf("@color %s = %v, want %v", tt.arg, sprite.Color, tt.want)
+		}
+	}
+}
+
+func TestDrawSpriteFrames(t *testing.T) {
+	sprite, err := ParseSprite("test", strings.NewReader("@frame\na\n@frame\nb\n@frame\nc\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewRenderer(1, 1, DefaultPalette)
+	for frame, want := range map[int]rune{0: 'a', 1: 'b', 2: 'c', 3: 'a', -1: 'c', -2: 'b', -3: 'a', -4: 'c'} {
+		if err := r.DrawSprite(sprite, frame, 0, 0); err != nil {
+			t.Fatalf("DrawSprite frame %d: %v", frame, err)
+		}
+		if got, _ := r.Cell(0, 0); got != want {
+			t.Errorf("DrawSprite frame %d drew %q, want %q", frame, got, want)
+		}
+	}
+}
+
+func TestDrawSpriteWideGlyphs(t *testing.T) {
+	sprite, err := ParseSprite("test", strings.NewReader("@frame\n你.b\n@colors\n1.2\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sprite.Width != 4 {
+		t.Errorf("Width = %d, want 4", sprite.Width)
+	}
+
+	r := NewRenderer(4, 1, DefaultPalette)
+	sprite.Transparent = '.'
+	if err := r.DrawSpriteColor(sprite, 0, 0, 0, ColorWhite); err != nil {
+		t.Fatal(err)
+	}
+
+	backend := NewMemoryBackend()
+	r.SetBackend(backend)
+	if err := r.Render(); err != nil {
+		t.Fatal(err)
+	}
+	if got := backend.Row(0); got != "你 b" {
+		t.Errorf("row = %q, want %q", got, "你 b")
+	}
+	for x, want := range []Color{ColorRed, ColorRed, ColorBlack, ColorGreen} {
+		if _, got := backend.Cell(x, 0); got != want {
+			t.Errorf("color at %d = %v, want %v", x, got, want)
+		}
+	}
+}
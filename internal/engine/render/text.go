@@ -0,0 +1,250 @@
+package render
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Align controls horizontal placement of text within a width
+type Align int
+
+const (
+	AlignLeft Align = iota
+	AlignCenter
+	AlignRight
+)
+
+// Ellipsis is appended to text truncated by Truncate
+const Ellipsis = '…'
+
+// wideTail marks the buffer cell covered by the right half of a wide rune
+const wideTail rune = 0
+
+// wide lists the East Asian Wide/Fullwidth ranges and emoji presentation
+// sequences that terminals draw two cells wide
+var wide = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x1100, Hi: 0x115f, Stride: 1},
+		{Lo: 0x231a, Hi: 0x231b, Stride: 1},
+		{Lo: 0x2329, Hi: 0x232a, Stride: 1},
+		{Lo: 0x23e9, Hi: 0x23ec, Stride: 1},
+		{Lo: 0x23f0, Hi: 0x23f0, Stride: 1},
+		{Lo: 0x23f3, Hi: 0x23f3, Stride: 1},
+		{Lo: 0x25fd, Hi: 0x25fe, Stride: 1},
+		{Lo: 0x2614, Hi: 0x2615, Stride: 1},
+		{Lo: 0x2648, Hi: 0x2653, Stride: 1},
+		{Lo: 0x267f, Hi: 0x267f, Stride: 1},
+		{Lo: 0x2693, Hi: 0x2693, Stride: 1},
+		{Lo: 0x26a1, Hi: 0x26a1, Stride: 1},
+		{Lo: 0x26aa, Hi: 0x26ab, Stride: 1},
+		{Lo: 0x26bd, Hi: 0x26be, Stride: 1},
+		{Lo: 0x26c4, Hi: 0x26c5, Stride: 1},
+		{Lo: 0x26ce, Hi: 0x26ce, Stride: 1},
+		{Lo: 0x26d4, Hi: 0x26d4, Stride: 1},
+		{Lo: 0x26ea, Hi: 0x26ea, Stride: 1},
+		{Lo: 0x26f2, Hi: 0x26f3, Stride: 1},
+		{Lo: 0x26f5, Hi: 0x26f5, Stride: 1},
+		{Lo: 0x26fa, Hi: 0x26fa, Stride: 1},
+		{Lo: 0x26fd, Hi: 0x26fd, Stride: 1},
+		{Lo: 0x2705, Hi: 0x2705, Stride: 1},
+		{Lo: 0x270a, Hi: 0x270b, Stride: 1},
+		{Lo: 0x2728, Hi: 0x2728, Stride: 1},
+		{Lo: 0x274c, Hi: 0x274c, Stride: 1},
+		{Lo: 0x274e, Hi: 0x274e, Stride: 1},
+		{Lo: 0x2753, Hi: 0x2755, Stride: 1},
+		{Lo: 0x2757, Hi: 0x2757, Stride: 1},
+		{Lo: 0x2795, Hi: 0x2797, Stride: 1},
+		{Lo: 0x27b0, Hi: 0x27b0, Stride: 1},
+		{Lo: 0x27bf, Hi: 0x27bf, Stride: 1},
+		{Lo: 0x2b1b, Hi: 0x2b1c, Stride: 1},
+		{Lo: 0x2b50, Hi: 0x2b50, Stride: 1},
+		{Lo: 0x2b55, Hi: 0x2b55, Stride: 1},
+		{Lo: 0x2e80, Hi: 0x303e, Stride: 1},
+		{Lo: 0x3041, Hi: 0x33ff, Stride: 1},
+		{Lo: 0x3400, Hi: 0x4dbf, Stride: 1},
+		{Lo: 0x4e00, Hi: 0x9fff, Stride: 1},
+		{Lo: 0xa000, Hi: 0xa4cf, Stride: 1},
+		{Lo: 0xa960, Hi: 0xa97f, Stride: 1},
+		{Lo: 0xac00, Hi: 0xd7a3, Stride: 1},
+		{Lo: 0xf900, Hi: 0xfaff, Stride: 1},
+		{Lo: 0xfe10, Hi: 0xfe19, Stride: 1},
+		{Lo: 0xfe30, Hi: 0xfe6f, Stride: 1},
+		{Lo: 0xff00, Hi: 0xff60, Stride: 1},
+		{Lo: 0xffe0, Hi: 0xffe6, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1f004, Hi: 0x1f004, Stride: 1},
+		{Lo: 0x1f0cf, Hi: 0x1f0cf, Stride: 1},
+		{Lo: 0x1f18e, Hi: 0x1f18e, Stride: 1},
+		{Lo: 0x1f191, Hi: 0x1f19a, Stride: 1},
+		{Lo: 0x1f200, Hi: 0x1f251, Stride: 1},
+		{Lo: 0x1f300, Hi: 0x1f64f, Stride: 1},
+		{Lo: 0x1f680, Hi: 0x1f6ff, Stride: 1},
+		{Lo: 0x1f7e0, Hi: 0x1f7eb, Stride: 1},
+		{Lo: 0x1f90c, Hi: 0x1f9ff, Stride: 1},
+		{Lo: 0x1fa70, Hi: 0x1faff, Stride: 1},
+		{Lo: 0x20000, Hi: 0x2fffd, Stride: 1},
+		{Lo: 0x30000, Hi: 0x3fffd, Stride: 1},
+	},
+}
+
+// RuneWidth returns the number of terminal cells a rune occupies: 0 for
+// control and combining characters, 2 for wide characters and 1 otherwise
+func RuneWidth(r rune) int {
+	switch {
+	case r == 0, r < 32, r >= 0x7f && r < 0xa0:
+		return 0
+	case r == 0x200d, r >= 0xfe00 && r <= 0xfe0f:
+		// zero width joiner and variation selectors
+		return 0
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		return 0
+	case r < 0x1100:
+		return 1
+	case unicode.Is(wide, r):
+		return 2
+	default:
+		return 1
+	}
+}
+
+// StringWidth returns the number of terminal cells a string occupies
+func StringWidth(s string) int {
+	width := 0
+	for _, r := range s {
+		width += RuneWidth(r)
+	}
+	return width
+}
+
+// Truncate shortens s to fit within width cells, replacing the overflow with an ellipsis
+func Truncate(s string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	if StringWidth(s) <= width {
+		return s
+	}
+
+	var sb strings.Builder
+	used := 0
+	for _, r := range s {
+		w := RuneWidth(r)
+		if used+w > width-1 {
+			break
+		}
+		sb.WriteRune(r)
+		used += w
+	}
+	sb.WriteRune(Ellipsis)
+	return sb.String()
+}
+
+// Wrap breaks s into lines no wider than width cells, splitting on spaces
+// where possible and hard-breaking words that are too long. Explicit newlines
+// are preserved.
+func Wrap(s string, width int) []string {
+	if width <= 0 {
+		return nil
+	}
+
+	lines := make([]string, 0)
+	for _, paragraph := range strings.Split(s, "\n") {
+		line, lineWidth := "", 0
+		for _, word := range strings.Fields(paragraph) {
+			wordWidth := StringWidth(word)
+
+			// Hard-break words longer than a full line
+			for wordWidth > width {
+				if lineWidth > 0 {
+					lines = append(lines, line)
+					line, lineWidth = "", 0
+				}
+				head, rest := splitWidth(word, width)
+				if rest == "" {
+					// A single rune wider than the line overflows it on its own
+					break
+				}
+				lines = append(lines, head)
+				word, wordWidth = rest, StringWidth(rest)
+			}
+
+			switch {
+			case wordWidth == 0:
+			case lineWidth == 0:
+				line, lineWidth = word, wordWidth
+			case lineWidth+1+wordWidth <= width:
+				line += " " + word
+				lineWidth += 1 + wordWidth
+			default:
+				lines = append(lines, line)
+				line, lineWidth = word, wordWidth
+			}
+		}
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+// splitWidth splits s after at most width cells. It always keeps the first
+// visible rune, even if it's wider than width, so callers always progress.
+func splitWidth(s string, width int) (string, string) {
+	used := 0
+	for i, r := range s {
+		w := RuneWidth(r)
+		if used+w > width && used > 0 {
+			return s[:i], s[i:]
+		}
+		used += w
+	}
+	return s, ""
+}
+
+// ellipsize ends s with an ellipsis, dropping runes if needed to stay within width
+func ellipsize(s string, width int) string {
+	if StringWidth(s) < width {
+		return s + string(Ellipsis)
+	}
+	return Truncate(s+" ", width)
+}
+
+// alignOffset returns the column offset for text of textWidth inside width
+func alignOffset(textWidth, width int, align Align) int {
+	switch align {
+	case AlignCenter:
+		return (width - textWidth) / 2
+	case AlignRight:
+		return width - textWidth
+	default:
+		return 0
+	}
+}
+
+// DrawTextAligned draws a single line of text aligned within width cells
+// starting at x. Text wider than width is truncated with an ellipsis.
+func (r *Renderer) DrawTextAligned(text string, x, y, width int, align Align, color Color) error {
+	text = Truncate(text, width)
+	return r.DrawText(text, x+alignOffset(StringWidth(text), width, align), y, color)
+}
+
+// DrawTextBox word-wraps text into rect and draws it with the given
+// alignment. If the text needs more lines than fit, the last visible line is
+// ellipsized. It returns the number of lines drawn.
+func (r *Renderer) DrawTextBox(text string, rect Rect, align Align, color Color) (int, error) {
+	lines := Wrap(text, rect.Width)
+	if len(lines) > rect.Height {
+		lines = lines[:max(rect.Height, 0)]
+		if n := len(lines); n > 0 {
+			lines[n-1] = ellipsize(lines[n-1], rect.Width)
+		}
+	}
+
+	var err error
+	for i, line := range lines {
+		if e := r.DrawTextAligned(line, rect.X, rect.Y+i, rect.Width, align, color); e != nil && err == nil {
+			err = e
+		}
+	}
+	return len(lines), err
+}
@@ -0,0 +1,119 @@
+package render
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRuneWidth(t *testing.T) {
+	tests := []struct {
+		r    rune
+		want int
+	}{
+		{'a', 1},
+		{' ', 1},
+		{'\n', 0},
+		{'é', 1},
+		{'́', 0}, // combining acute accent
+		{'‍', 0}, // zero width joiner
+		{'️', 0}, // variation selector
+		{'你', 2},
+		{'ア', 2},
+		{'🚀', 2},
+		{'█', 1},
+	}
+	for _, tt := range tests {
+		if got := RuneWidth(tt.r); got != tt.want {
+			t.Errorf("RuneWidth(%q) = %d, want %d", tt.r, got, tt.want)
+		}
+	}
+}
+
+func TestStringWidth(t *testing.T) {
+	tests := []struct {
+		s    string
+		want int
+	}{
+		{"", 0},
+		{"hello", 5},
+		{"你好", 4},
+		{"é", 1},
+		{"a你b", 4},
+	}
+	for _, tt := range tests {
+		if got := StringWidth(tt.s); got != tt.want {
+			t.Errorf("StringWidth(%q) = %d, want %d", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		s     string
+		width int
+		want  string
+	}{
+		{"hello", 10, "hello"},
+		{"hello", 5, "hello"},
+		{"hello", 4, "hel…"},
+		{"hello", 1, "…"},
+		{"hello", 0, ""},
+		{"你好世界", 5, "你好…"},
+		{"你好世界", 4, "你…"},
+		{"ééé", 2, "é…"},
+	}
+	for _, tt := range tests {
+		if got := Truncate(tt.s, tt.width); got != tt.want {
+			t.Errorf("Truncate(%q, %d) = %q, want %q", tt.s, tt.width, got, tt.want)
+		}
+	}
+}
+
+func TestWrap(t *testing.T) {
+	tests := []struct {
+		s     string
+		width int
+		want  []string
+	}{
+		{"the quick brown fox", 10, []string{"the quick", "brown fox"}},
+		{"the quick brown fox", 0, nil},
+		{"the quick brown fox", -1, nil},
+		{"abc", 1, []string{"a", "b", "c"}},
+		{"abcdef", 4, []string{"abcd", "ef"}},
+		{"one\ntwo", 10, []string{"one", "two"}},
+		{"", 5, []string{""}},
+		// Wide runes that don't fit still take a line each
+		{"你好", 1, []string{"你", "好"}},
+		{"你好世界", 3, []string{"你", "好", "世", "界"}},
+		{"你好世界", 4, []string{"你好", "世界"}},
+		{"a你", 2, []string{"a", "你"}},
+		// Combining marks stay with the rune before them
+		{"ééé", 2, []string{"éé", "é"}},
+		{"éé", 1, []string{"é", "é"}},
+	}
+	for _, tt := range tests {
+		got := Wrap(tt.s, tt.width)
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("Wrap(%q, %d) = %q, want %q", tt.s, tt.width, got, tt.want)
+		}
+		if tt.width <= 0 {
+			continue
+		}
+		for _, line := range got {
+			// Only a single wide rune may overflow a line
+			if StringWidth(line) > tt.width && len([]rune(line)) > 1 {
+				t.Errorf("Wrap(%q, %d) line %q is wider than %d", tt.s, tt.width, line, tt.width)
+			}
+		}
+	}
+}
+
+func TestDrawTextBoxNarrow(t *testing.T) {
+	r := NewRenderer(4, 4, DefaultPalette)
+	for width := 0; width <= 3; width++ {
+		// Must not hang on boxes narrower than a rune
+		if _, err := r.DrawTextBox("你好 世界", Rect{X: 0, Y: 0, Width: width, Height: 4}, AlignLeft, ColorWhite); err != nil {
+			t.Errorf("DrawTextBox width %d: %v", width, err)
+		}
+	}
+}
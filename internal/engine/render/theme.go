@@ -0,0 +1,312 @@
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// Semantic color roles a theme assigns colors to
+const (
+	RolePlayer     = "player"
+	RoleEnemy      = "enemy"
+	RoleObstacle   = "obstacle"
+	RoleHUD        = "hud"
+	RoleTitle      = "title"
+	RoleHeading    = "heading"
+	RoleText       = "text"
+	RoleAccent     = "accent"
+	RoleStatus     = "status"
+	RoleSuccess    = "success"
+	RoleWarning    = "warning"
+	RoleDanger     = "danger"
+	RoleDebug      = "debug"
+	RoleOverlay    = "overlay"
+	RoleBackground = "background"
+)
+
+// Theme maps semantic roles to palette colors, and optionally picks the
+// palette used to present them. Themes are stored as JSON:
+//
+//	{"name": "dusk", "palette": "default", "colors": {"player": "bright_cyan"}}
+//
+// Roles a theme leaves out fall back to the default theme. Roles the default
+// theme leaves out too, like RolePlayer, fall back to the color each game
+// passes to ColorOr, so the default theme draws every game in its own colors.
+// Games name their own roles the same way, e.g. "shield", so themes can
+// recolor those too.
+//
+// Semantic themes, like the colorblind and monochrome themes, color every
+// game role by the semantic role it stands for instead of the game's own
+// color, see ColorAs, and draw fixed ramps like heat maps in one color, see
+// RampColor.
+type Theme struct {
+	Name     string           `json:"name"`
+	Palette  string           `json:"palette,omitempty"`
+	Semantic bool             `json:"semantic,omitempty"`
+	Colors   map[string]Color `json:"colors"`
+
+	// palette is the resolved Palette, nil keeps the renderer's own
+	palette *Palette
+}
+
+// Color returns the color assigned to role
+func (t *Theme) Color(role string) Color {
+	return t.ColorOr(role, ColorWhite)
+}
+
+// ColorOr returns the color assigned to role, or fallback if neither the
+// theme nor the default theme assigns one
+func (t *Theme) ColorOr(role string, fallback Color) Color {
+	if color, ok := t.Colors[role]; ok {
+		return color
+	}
+	if color, ok := DefaultTheme.Colors[role]; ok {
+		return color
+	}
+	return fallback
+}
+
+// ColorAs returns the color assigned to a game role. Semantic themes that
+// leave the role out use the color of the semantic role it stands for, e.g.
+// RoleDanger for a boss, other themes fall back like ColorOr.
+func (t *Theme) ColorAs(role, semantic string, fallback Color) Color {
+	if color, ok := t.Colors[role]; ok {
+		return color
+	}
+	if t.Semantic {
+		return t.Color(semantic)
+	}
+	return t.ColorOr(role, fallback)
+}
+
+// RampColor returns color, a step along a fixed ramp like a heat map. On
+// semantic themes the whole ramp collapses to the color of role.
+func (t *Theme) RampColor(role string, color Color) Color {
+	if t.Semantic {
+		return t.Color(role)
+	}
+	return color
+}
+
+// DefaultTheme matches the colors the games were designed with. It leaves
+// RolePlayer out since every game colors its player differently.
+var DefaultTheme = &Theme{
+	Name: "default",
+	Colors: map[string]Color{
+		RoleEnemy:      ColorWhite,
+		RoleObstacle:   ColorGreen,
+		RoleHUD:        ColorWhite,
+		RoleTitle:      ColorWhite,
+		RoleHeading:    ColorBlue,
+		RoleText:       ColorWhite,
+		RoleAccent:     ColorBrightMagenta,
+		RoleStatus:     ColorMagenta,
+		RoleSuccess:    ColorGreen,
+		RoleWarning:    ColorYellow,
+		RoleDanger:     ColorRed,
+		RoleDebug:      ColorBrightBlue,
+		RoleOverlay:    ColorWhite,
+		RoleBackground: ColorBlack,
+	},
+}
+
+// ColorblindTheme avoids red/green distinctions, leaning on blue and yellow
+var ColorblindTheme = &Theme{
+	Name:     "colorblind",
+	Semantic: true,
+	Colors: map[string]Color{
+		RolePlayer:     ColorBrightBlue,
+		RoleEnemy:      ColorBrightYellow,
+		RoleObstacle:   ColorBrightWhite,
+		RoleHUD:        ColorWhite,
+		RoleTitle:      ColorBrightWhite,
+		RoleHeading:    ColorBrightBlue,
+		RoleText:       ColorWhite,
+		RoleAccent:     ColorBrightYellow,
+		RoleStatus:     ColorYellow,
+		RoleSuccess:    ColorBrightBlue,
+		RoleWarning:    ColorYellow,
+		RoleDanger:     ColorBrightMagenta,
+		RoleDebug:      ColorBrightCyan,
+		RoleOverlay:    ColorWhite,
+		RoleBackground: ColorBlack,
+	},
+}
+
+// MonochromeTheme draws everything in shades of white
+var MonochromeTheme = &Theme{
+	Name:     "monochrome",
+	Palette:  "monochrome",
+	Semantic: true,
+	Colors: map[string]Color{
+		RolePlayer:     ColorBrightWhite,
+		RoleEnemy:      ColorWhite,
+		RoleObstacle:   ColorWhite,
+		RoleHUD:        ColorWhite,
+		RoleTitle:      ColorBrightWhite,
+		RoleHeading:    ColorBrightWhite,
+		RoleText:       ColorWhite,
+		RoleAccent:     ColorBrightWhite,
+		RoleStatus:     ColorBrightWhite,
+		RoleSuccess:    ColorWhite,
+		RoleWarning:    ColorBrightWhite,
+		RoleDanger:     ColorBrightWhite,
+		RoleDebug:      ColorWhite,
+		RoleOverlay:    ColorWhite,
+		RoleBackground: ColorBlack,
+	},
+}
+
+// Themes lists the built-in themes by name
+var Themes = map[string]*Theme{
+	DefaultTheme.Name:    DefaultTheme,
+	ColorblindTheme.Name: ColorblindTheme,
+	MonochromeTheme.Name: MonochromeTheme,
+}
+
+// MonochromePalette presents every color as plain white or bright white
+var MonochromePalette = mapPalette(func(c ColorInfo, i int) ColorInfo {
+	if i >= int(ColorBrightBlack) {
+		return ColorInfo{c.Name, "\033[97m", [3]uint8{255, 255, 255}}
+	}
+	return ColorInfo{c.Name, "\033[37m", [3]uint8{229, 229, 229}}
+})
+
+// NoColorPalette emits no color escape codes at all, see https://no-color.org
+var NoColorPalette = mapPalette(func(c ColorInfo, _ int) ColorInfo {
+	return ColorInfo{c.Name, "", [3]uint8{229, 229, 229}}
+})
+
+// Palettes lists the built-in palettes by name
+var Palettes = map[string]Palette{
+	"default":    DefaultPalette,
+	"monochrome": MonochromePalette,
+	"none":       NoColorPalette,
+}
+
+// mapPalette derives a palette from DefaultPalette
+func mapPalette(fn func(ColorInfo, int) ColorInfo) Palette {
+	colors := make([]ColorInfo, len(DefaultPalette.Colors))
+	for i, c := range DefaultPalette.Colors {
+		colors[i] = fn(c, i)
+	}
+	return Palette{Colors: colors}
+}
+
+// activeTheme is picked up by renderers as they are created
+var activeTheme = DefaultTheme
+
+// UseTheme sets the theme used by renderers created afterwards
+func UseTheme(theme *Theme) {
+	activeTheme = theme
+}
+
+// CurrentTheme returns the theme set with UseTheme
+func CurrentTheme() *Theme {
+	return activeTheme
+}
+
+// NoColor reports whether the NO_COLOR environment variable asks for plain output
+func NoColor() bool {
+	return os.Getenv("NO_COLOR") != ""
+}
+
+// LoadTheme finds a theme by name, preferring workDir/themes/<name>.json over
+// the built-in themes, and resolves its palette. When NO_COLOR is set the
+// palette is replaced with NoColorPalette and the theme made semantic, so
+// colors that only rank things collapse too.
+func LoadTheme(workDir, name string) (*Theme, error) {
+	theme, err := readTheme(filepath.Join(workDir, "themes", name+".json"))
+	if os.IsNotExist(err) {
+		builtin, ok := Themes[name]
+		if !ok {
+			return nil, fmt.Errorf("theme %q not found", name)
+		}
+		copied := *builtin
+		theme, err = &copied, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case NoColor():
+		theme.palette = &NoColorPalette
+		theme.Semantic = true
+	case theme.Palette != "":
+		palette, err := LoadPalette(workDir, theme.Palette)
+		if err != nil {
+			return nil, err
+		}
+		theme.palette = &palette
+	}
+
+	return theme, nil
+}
+
+func readTheme(filename string) (*Theme, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	theme := &Theme{}
+	if err := json.NewDecoder(file).Decode(theme); err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+	return theme, nil
+}
+
+// LoadPalette finds a palette by name, preferring workDir/palettes/<name>.json
+// over the built-in palettes. Palette files must define all 16 colors.
+func LoadPalette(workDir, name string) (Palette, error) {
+	filename := filepath.Join(workDir, "palettes", name+".json")
+	file, err := os.Open(filename)
+	if os.IsNotExist(err) {
+		palette, ok := Palettes[name]
+		if !ok {
+			return Palette{}, fmt.Errorf("palette %q not found", name)
+		}
+		return palette, nil
+	} else if err != nil {
+		return Palette{}, err
+	}
+	defer file.Close()
+
+	var palette Palette
+	if err := json.NewDecoder(file).Decode(&palette); err != nil {
+		return Palette{}, fmt.Errorf("%s: %w", filename, err)
+	}
+	if len(palette.Colors) < len(DefaultPalette.Colors) {
+		return Palette{}, fmt.Errorf("%s: palette defines %d colors, want %d", filename, len(palette.Colors), len(DefaultPalette.Colors))
+	}
+	return palette, nil
+}
+
+// MarshalText encodes a color by its default palette name
+func (c Color) MarshalText() ([]byte, error) {
+	if c < 0 || int(c) >= len(DefaultPalette.Colors) {
+		return nil, fmt.Errorf("invalid color %d", int(c))
+	}
+	return []byte(DefaultPalette.Colors[c].Name), nil
+}
+
+// UnmarshalText decodes a color from its default palette name or index
+func (c *Color) UnmarshalText(text []byte) error {
+	for i, info := range DefaultPalette.Colors {
+		if info.Name == string(text) {
+			*c = Color(i)
+			return nil
+		}
+	}
+
+	if i, err := strconv.Atoi(string(text)); err == nil && i >= 0 && i < len(DefaultPalette.Colors) {
+		*c = Color(i)
+		return nil
+	}
+	return fmt.Errorf("unknown color %q", text)
+}
@@ -0,0 +1,93 @@
+package render
+
+import "testing"
+
+func TestThemeColorOr(t *testing.T) {
+	custom := &Theme{Name: "custom", Colors: map[string]Color{RolePlayer: ColorRed, "shield": ColorMagenta}}
+	tests := []struct {
+		theme    *Theme
+		role     string
+		fallback Color
+		want     Color
+	}{
+		// The default theme leaves the player to each game
+		{DefaultTheme, RolePlayer, ColorYellow, ColorYellow},
+		{DefaultTheme, RoleHeading, ColorYellow, ColorBlue},
+		{DefaultTheme, "shield", ColorBlue, ColorBlue},
+		{custom, RolePlayer, ColorYellow, ColorRed},
+		{custom, "shield", ColorBlue, ColorMagenta},
+		// Roles the theme leaves out come from the default theme
+		{custom, RoleStatus, ColorWhite, ColorMagenta},
+		{ColorblindTheme, RolePlayer, ColorYellow, ColorblindTheme.Colors[RolePlayer]},
+	}
+	for _, tt := range tests {
+		if got := tt.theme.ColorOr(tt.role, tt.fallback); got != tt.want {
+			t.Errorf("%s.ColorOr(%q, %v) = %v, want %v", tt.theme.Name, tt.role, tt.fallback, got, tt.want)
+		}
+	}
+
+	if got := DefaultTheme.Color("unknown"); got != ColorWhite {
+		t.Errorf("Color of an unknown role = %v, want %v", got, ColorWhite)
+	}
+}
+
+func TestThemeColorAs(t *testing.T) {
+	custom := &Theme{Name: "custom", Colors: map[string]Color{"boss": ColorCyan, RoleDanger: ColorYellow}}
+	tests := []struct {
+		theme *Theme
+		role  string
+		want  Color
+	}{
+		// Game roles keep the game's own colors unless the theme is semantic
+		{DefaultTheme, "boss", ColorRed},
+		{custom, "boss", ColorCyan},
+		{custom, "tough", ColorGreen},
+		{ColorblindTheme, "boss", ColorblindTheme.Colors[RoleDanger]},
+		{ColorblindTheme, "tough", ColorblindTheme.Colors[RoleWarning]},
+		{MonochromeTheme, "boss", MonochromeTheme.Colors[RoleDanger]},
+	}
+	semantic := map[string]string{"boss": RoleDanger, "tough": RoleWarning}
+	fallback := map[string]Color{"boss": ColorRed, "tough": ColorGreen}
+	for _, tt := range tests {
+		if got := tt.theme.ColorAs(tt.role, semantic[tt.role], fallback[tt.role]); got != tt.want {
+			t.Errorf("%s.ColorAs(%q) = %v, want %v", tt.theme.Name, tt.role, got, tt.want)
+		}
+	}
+
+	for _, theme := range []*Theme{ColorblindTheme, MonochromeTheme} {
+		for role, color := range fallback {
+			if got := theme.ColorAs(role, semantic[role], color); got == ColorRed || got == ColorGreen {
+				t.Errorf("%s.ColorAs(%q) = %v, want neither red nor green", theme.Name, role, got)
+			}
+		}
+	}
+}
+
+func TestThemeRampColor(t *testing.T) {
+	ramp := []Color{ColorRed, ColorYellow, ColorGreen}
+	for _, color := range ramp {
+		if got := DefaultTheme.RampColor(RoleText, color); got != color {
+			t.Errorf("default RampColor(%v) = %v, want it unchanged", color, got)
+		}
+		if got := MonochromeTheme.RampColor(RoleText, color); got != MonochromeTheme.Colors[RoleText] {
+			t.Errorf("monochrome RampColor(%v) = %v, want %v", color, got, MonochromeTheme.Colors[RoleText])
+		}
+	}
+}
+
+func TestLoadThemeNoColor(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	theme, err := LoadTheme(t.TempDir(), "default")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !theme.Semantic || theme.palette == nil || theme.palette.Colors[ColorRed].ANSI != "" {
+		t.Errorf("NO_COLOR theme = %+v, want a semantic theme without color codes", theme)
+	}
+	if got := theme.RampColor(RoleText, ColorRed); got != theme.Color(RoleText) {
+		t.Errorf("RampColor under NO_COLOR = %v, want %v", got, theme.Color(RoleText))
+	}
+	if DefaultTheme.Semantic {
+		t.Error("NO_COLOR changed the built-in default theme")
+	}
+}
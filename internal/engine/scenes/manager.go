@@ -1,23 +1,81 @@
 package scenes
 
 import (
+	"errors"
+	"fmt"
+	"slices"
+
 	"github.com/kuhree/gg/internal/engine/core"
 	"github.com/kuhree/gg/internal/engine/render"
 )
 
 type SceneID int
 
+// NoScene is what Current returns while the stack is empty
+const NoScene SceneID = -1
+
+// ErrUnknownScene is returned when switching to a scene that was never added
+var ErrUnknownScene = errors.New("unknown scene")
+
 type Scene interface {
 	Enter()
 	Exit()
-	Update(dt float64)
+	Update(dt float64) error
 	Draw(renderer *render.Renderer)
 	HandleInput(input core.InputEvent) error
 }
 
+// Pauser is implemented by scenes that want to know when an overlay is
+// pushed on top of them and when it's popped again
+type Pauser interface {
+	Pause()
+	Resume()
+}
+
+// Overlay controls how a pushed scene treats the scenes below it
+type Overlay int
+
+const (
+	// Opaque hides the scenes below and stops updating them
+	Opaque Overlay = iota
+	// Frozen keeps drawing the scenes below but stops updating them
+	Frozen
+	// Live keeps drawing and updating the scenes below
+	Live
+)
+
+// Listener is called after the top scene changed from one scene to another.
+// from is NoScene for the first scene entered.
+type Listener func(from, to SceneID)
+
+// entry is a scene on the stack
+type entry struct {
+	id      SceneID
+	scene   Scene
+	overlay Overlay
+}
+
+// Manager keeps a stack of scenes. The top scene receives input, the scenes
+// below it keep drawing and updating as far as the overlays above allow.
+// While a transition runs, scenes are neither updated nor sent input.
+//
+// A transition enters the incoming scene right away but only exits the
+// outgoing scenes once it finishes, so they're never drawn after Exit. A
+// scene that is both outgoing and incoming is exited before it's entered.
+//
+// Scene switches requested while scenes are updating, drawing or handling
+// input are queued and applied at the end of Update, so a scene is never
+// exited while one of its own methods is still running.
 type Manager struct {
-	scenes       map[SceneID]Scene
-	currentScene Scene
+	scenes     map[SceneID]Scene
+	stack      []entry
+	transition *transition
+	listeners  []Listener
+
+	// busy is set while scene methods run, pending holds the switches
+	// requested meanwhile
+	busy    bool
+	pending []func()
 }
 
 func NewManager() *Manager {
@@ -30,29 +88,253 @@ func (m *Manager) AddScene(id SceneID, scene Scene) {
 	m.scenes[id] = scene
 }
 
-func (m *Manager) ChangeScene(id SceneID) {
-	if m.currentScene != nil {
-		m.currentScene.Exit()
+// Scene returns the scene added under id, or nil
+func (m *Manager) Scene(id SceneID) Scene {
+	return m.scenes[id]
+}
+
+// Current returns the id of the top scene, or NoScene if the stack is empty.
+// Queued switches aren't reflected until they're applied.
+func (m *Manager) Current() SceneID {
+	if top := m.top(); top != nil {
+		return top.id
+	}
+	return NoScene
+}
+
+// CurrentScene returns the top scene, or nil if the stack is empty
+func (m *Manager) CurrentScene() Scene {
+	if top := m.top(); top != nil {
+		return top.scene
+	}
+	return nil
+}
+
+// OnChange registers a listener called whenever the top scene changes
+func (m *Manager) OnChange(listener Listener) {
+	m.listeners = append(m.listeners, listener)
+}
+
+// ChangeScene exits every scene on the stack and enters id in their place
+func (m *Manager) ChangeScene(id SceneID) error {
+	return m.ChangeSceneWith(id, Transition{})
+}
+
+// ChangeSceneWith changes scene like ChangeScene, animating from the old
+// scenes to the new one with t. A Transition without a Style switches
+// instantly, otherwise the old scenes are exited when the transition ends.
+func (m *Manager) ChangeSceneWith(id SceneID, t Transition) error {
+	if err := m.check(id); err != nil {
+		return err
+	}
+
+	m.apply(func() {
+		m.replace(id, t)
+	})
+	return nil
+}
+
+// Transitioning reports whether a transition is running
+func (m *Manager) Transitioning() bool {
+	return m.transition != nil
+}
+
+// Push pauses the top scene and enters id on top of it
+func (m *Manager) Push(id SceneID, overlay Overlay) error {
+	if err := m.check(id); err != nil {
+		return err
 	}
-	m.currentScene = m.scenes[id]
-	m.currentScene.Enter()
+
+	m.apply(func() {
+		from := m.Current()
+		if top := m.top(); top != nil {
+			if pauser, ok := top.scene.(Pauser); ok {
+				pauser.Pause()
+			}
+		}
+		m.stack = append(m.stack, entry{id: id, scene: m.scenes[id], overlay: overlay})
+		m.scenes[id].Enter()
+		m.notify(from)
+	})
+	return nil
+}
+
+// Pop exits the top scene and resumes the one below it. The bottom scene is
+// never popped, use ChangeScene to replace it.
+func (m *Manager) Pop() {
+	m.apply(func() {
+		if len(m.stack) < 2 {
+			return
+		}
+
+		from := m.Current()
+		m.top().scene.Exit()
+		m.stack = m.stack[:len(m.stack)-1]
+		if pauser, ok := m.top().scene.(Pauser); ok {
+			pauser.Resume()
+		}
+		m.notify(from)
+	})
 }
 
-func (m *Manager) Update(dt float64) {
-	if m.currentScene != nil {
-		m.currentScene.Update(dt)
+// Depth returns the number of scenes on the stack
+func (m *Manager) Depth() int {
+	return len(m.stack)
+}
+
+// check returns ErrUnknownScene if no scene was added under id
+func (m *Manager) check(id SceneID) error {
+	if _, ok := m.scenes[id]; !ok {
+		return fmt.Errorf("%w: %d", ErrUnknownScene, id)
 	}
+	return nil
 }
 
+// apply runs a stack change now, or queues it while scene methods are running
+func (m *Manager) apply(change func()) {
+	if m.busy {
+		m.pending = append(m.pending, change)
+		return
+	}
+	change()
+}
+
+// flush applies the queued stack changes in the order they were requested
+func (m *Manager) flush() {
+	for len(m.pending) > 0 {
+		change := m.pending[0]
+		m.pending = m.pending[1:]
+		change()
+	}
+}
+
+// replace takes the whole stack out and enters id in its place. Without a
+// transition style the old stack is exited right away, otherwise when the
+// transition ends.
+func (m *Manager) replace(id SceneID, t Transition) {
+	from := m.Current()
+	m.endTransition()
+
+	incoming := m.scenes[id]
+	outgoing := m.stack
+	m.stack = []entry{{id: id, scene: incoming}}
+	if t.Style == nil {
+		exitStack(outgoing)
+	} else {
+		// The incoming scene can't wait for the transition to exit
+		outgoing = slices.DeleteFunc(outgoing, func(e entry) bool {
+			if e.scene == incoming {
+				e.scene.Exit()
+				return true
+			}
+			return false
+		})
+		m.transition = &transition{Transition: t, from: outgoing}
+	}
+
+	incoming.Enter()
+	m.notify(from)
+}
+
+// endTransition stops the running transition, exiting its outgoing scenes
+func (m *Manager) endTransition() {
+	if m.transition == nil {
+		return
+	}
+	from := m.transition.from
+	m.transition = nil
+	exitStack(from)
+}
+
+// exitStack exits the scenes from the top down
+func exitStack(stack []entry) {
+	for i := len(stack) - 1; i >= 0; i-- {
+		stack[i].scene.Exit()
+	}
+}
+
+// notify calls the listeners after the top scene changed from from
+func (m *Manager) notify(from SceneID) {
+	to := m.Current()
+	for _, listener := range m.listeners {
+		listener(from, to)
+	}
+}
+
+// top returns the top of the stack, or nil if it's empty
+func (m *Manager) top() *entry {
+	if len(m.stack) == 0 {
+		return nil
+	}
+	return &m.stack[len(m.stack)-1]
+}
+
+// Update updates the top scene, and the scenes below it through Live overlays,
+// then applies the scene switches requested during the frame. It stops at the
+// first scene that returns an error and returns it.
+func (m *Manager) Update(dt float64) error {
+	defer m.flush()
+
+	if m.transition != nil {
+		m.transition.elapsed += dt
+		if m.transition.done() {
+			m.endTransition()
+		}
+		return nil
+	}
+
+	m.busy = true
+	defer func() { m.busy = false }()
+
+	for i := len(m.stack) - 1; i >= 0; i-- {
+		if err := m.stack[i].scene.Update(dt); err != nil {
+			return err
+		}
+		if m.stack[i].overlay != Live {
+			return nil
+		}
+	}
+	return nil
+}
+
+// Draw draws the scenes, or the running transition between them
 func (m *Manager) Draw(renderer *render.Renderer) {
-	if m.currentScene != nil {
-		m.currentScene.Draw(renderer)
+	m.busy = true
+	defer func() { m.busy = false }()
+
+	if m.transition == nil {
+		drawStack(m.stack, renderer)
+		return
 	}
+
+	from, to := m.transition.buffers(renderer)
+	drawStack(m.transition.from, from)
+	drawStack(m.stack, to)
+	m.transition.Style(renderer, from, to, m.transition.progress())
 }
 
+// drawStack draws the scenes from the bottom up, starting at the topmost Opaque one
+func drawStack(stack []entry, renderer *render.Renderer) {
+	bottom := len(stack) - 1
+	for bottom > 0 && stack[bottom].overlay != Opaque {
+		bottom--
+	}
+	for i := max(bottom, 0); i < len(stack); i++ {
+		stack[i].scene.Draw(renderer)
+	}
+}
+
+// HandleInput passes input to the top scene only, dropping it during
+// transitions. Scene switches it requests are applied by the next Update.
 func (m *Manager) HandleInput(input core.InputEvent) error {
-	if m.currentScene != nil {
-		return m.currentScene.HandleInput(input)
+	if m.transition != nil {
+		return nil
+	}
+
+	m.busy = true
+	defer func() { m.busy = false }()
+	if top := m.top(); top != nil {
+		return top.scene.HandleInput(input)
 	}
 	return nil
 }
@@ -0,0 +1,165 @@
+package scenes
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/kuhree/gg/internal/engine/core"
+	"github.com/kuhree/gg/internal/engine/render"
+)
+
+// recorder is a scene that logs its calls and fails the test if it's drawn
+// or updated while it isn't entered
+type recorder struct {
+	t       *testing.T
+	name    string
+	log     *[]string
+	entered bool
+	update  func()
+}
+
+func (s *recorder) Enter() {
+	s.entered = true
+	*s.log = append(*s.log, s.name+".Enter")
+}
+
+func (s *recorder) Exit() {
+	s.entered = false
+	*s.log = append(*s.log, s.name+".Exit")
+}
+
+func (s *recorder) Update(dt float64) error {
+	if !s.entered {
+		s.t.Errorf("%s updated after Exit", s.name)
+	}
+	if s.update != nil {
+		s.update()
+	}
+	return nil
+}
+
+func (s *recorder) Draw(renderer *render.Renderer) {
+	if !s.entered {
+		s.t.Errorf("%s drawn after Exit", s.name)
+	}
+	_ = renderer.DrawText(s.name, 0, 0, render.ColorWhite)
+}
+
+func (s *recorder) HandleInput(input core.InputEvent) error {
+	return nil
+}
+
+// newTestManager creates a manager with scenes a, b and c, having entered a
+func newTestManager(t *testing.T) (*Manager, map[string]*recorder, *[]string) {
+	log := &[]string{}
+	m := NewManager()
+	scenes := map[string]*recorder{}
+	for i, name := range []string{"a", "b", "c"} {
+		scenes[name] = &recorder{t: t, name: name, log: log}
+		m.AddScene(SceneID(i), scenes[name])
+	}
+	if err := m.ChangeScene(0); err != nil {
+		t.Fatal(err)
+	}
+	*log = nil
+	return m, scenes, log
+}
+
+func wantLog(t *testing.T, log *[]string, want ...string) {
+	t.Helper()
+	if !slices.Equal(*log, want) {
+		t.Errorf("calls = %v, want %v", *log, want)
+	}
+	*log = nil
+}
+
+var testTransition = Transition{Style: Fade, Duration: 1}
+
+func TestChangeSceneExitsRightAway(t *testing.T) {
+	m, _, log := newTestManager(t)
+	if err := m.ChangeScene(1); err != nil {
+		t.Fatal(err)
+	}
+	wantLog(t, log, "a.Exit", "b.Enter")
+	if err := m.ChangeScene(7); err == nil {
+		t.Error("ChangeScene to an unknown scene succeeded")
+	}
+}
+
+func TestTransitionExitsWhenDone(t *testing.T) {
+	m, _, log := newTestManager(t)
+	r := render.NewRenderer(10, 2, render.DefaultPalette)
+
+	if err := m.ChangeSceneWith(1, testTransition); err != nil {
+		t.Fatal(err)
+	}
+	wantLog(t, log, "b.Enter")
+
+	for i := 0; i < 3; i++ {
+		m.Draw(r)
+		if err := m.Update(0.25); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !m.Transitioning() {
+		t.Fatal("transition ended early")
+	}
+	wantLog(t, log)
+
+	_ = m.Update(0.25)
+	if m.Transitioning() {
+		t.Fatal("transition still running")
+	}
+	wantLog(t, log, "a.Exit")
+	m.Draw(r)
+}
+
+func TestTransitionInterrupted(t *testing.T) {
+	m, _, log := newTestManager(t)
+	_ = m.ChangeSceneWith(1, testTransition)
+	_ = m.ChangeSceneWith(2, testTransition)
+	wantLog(t, log, "b.Enter", "a.Exit", "c.Enter")
+
+	_ = m.Update(1)
+	wantLog(t, log, "b.Exit")
+}
+
+func TestTransitionToSameScene(t *testing.T) {
+	m, scenes, log := newTestManager(t)
+	_ = m.ChangeSceneWith(0, testTransition)
+	wantLog(t, log, "a.Exit", "a.Enter")
+
+	_ = m.Update(1)
+	wantLog(t, log)
+	if !scenes["a"].entered {
+		t.Error("scene exited after transitioning to itself")
+	}
+}
+
+func TestChangeSceneDuringUpdateIsQueued(t *testing.T) {
+	m, scenes, log := newTestManager(t)
+	scenes["a"].update = func() {
+		if err := m.ChangeScene(1); err != nil {
+			t.Error(err)
+		}
+		*log = append(*log, fmt.Sprintf("current %d", m.Current()))
+	}
+
+	_ = m.Update(0.1)
+	wantLog(t, log, "current 0", "a.Exit", "b.Enter")
+}
+
+func TestPushPop(t *testing.T) {
+	m, _, log := newTestManager(t)
+	_ = m.Push(1, Frozen)
+	if m.Depth() != 2 || m.Current() != 1 {
+		t.Errorf("Depth, Current = %d, %d, want 2, 1", m.Depth(), m.Current())
+	}
+	m.Pop()
+	m.Pop() // the bottom scene stays
+	wantLog(t, log, "b.Enter", "b.Exit")
+	if m.Current() != 0 {
+		t.Errorf("Current = %d, want 0", m.Current())
+	}
+}
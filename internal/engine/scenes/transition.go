@@ -0,0 +1,138 @@
+package scenes
+
+import (
+	"math"
+
+	"github.com/kuhree/gg/internal/engine/render"
+	"github.com/kuhree/gg/internal/engine/timing"
+)
+
+// Style draws one frame of a transition into dst from the outgoing and
+// incoming frames, as progress goes from 0 to 1
+type Style func(dst, from, to *render.Renderer, progress float64)
+
+// Transition animates a scene change
+type Transition struct {
+	Style Style
+	// Duration in seconds
+	Duration float64
+	// Easing shapes the progress, nil is linear
+	Easing timing.Easing
+}
+
+// Fade fades the outgoing scene to black, then the incoming one in
+func Fade(dst, from, to *render.Renderer, progress float64) {
+	if progress < 0.5 {
+		dst.Copy(from)
+		render.Fade{}.Apply(dst, progress*2)
+		return
+	}
+	dst.Copy(to)
+	render.Fade{}.Apply(dst, 2-progress*2)
+}
+
+// WipeRight uncovers the incoming scene from left to right
+func WipeRight(dst, from, to *render.Renderer, progress float64) {
+	width, _ := dst.Size()
+	edge := int(progress * float64(width))
+	dst.Blend(from, to, func(x, y int) bool { return x < edge })
+}
+
+// WipeLeft uncovers the incoming scene from right to left
+func WipeLeft(dst, from, to *render.Renderer, progress float64) {
+	width, _ := dst.Size()
+	edge := width - int(progress*float64(width))
+	dst.Blend(from, to, func(x, y int) bool { return x >= edge })
+}
+
+// WipeDown uncovers the incoming scene from top to bottom
+func WipeDown(dst, from, to *render.Renderer, progress float64) {
+	_, height := dst.Size()
+	edge := int(progress * float64(height))
+	dst.Blend(from, to, func(x, y int) bool { return y < edge })
+}
+
+// WipeUp uncovers the incoming scene from bottom to top
+func WipeUp(dst, from, to *render.Renderer, progress float64) {
+	_, height := dst.Size()
+	edge := height - int(progress*float64(height))
+	dst.Blend(from, to, func(x, y int) bool { return y >= edge })
+}
+
+// Dissolve swaps cells over to the incoming scene in a fixed random order
+func Dissolve(dst, from, to *render.Renderer, progress float64) {
+	dst.Blend(from, to, func(x, y int) bool { return noise(x, y) < progress })
+}
+
+// noise returns a stable pseudo-random value in [0, 1) for a cell
+func noise(x, y int) float64 {
+	h := uint32(x)*374761393 + uint32(y)*668265263
+	h = (h ^ (h >> 13)) * 1274126177
+	h ^= h >> 16
+	return float64(h) / (1 << 32)
+}
+
+// Iris opens a circle from the center of the screen onto the incoming scene
+func Iris(dst, from, to *render.Renderer, progress float64) {
+	width, height := dst.Size()
+	cx, cy := float64(width)/2, float64(height)/2
+	// Cells are about twice as tall as wide, so stretch y to keep it round
+	radius := progress * math.Hypot(cx, cy*2)
+	dst.Blend(from, to, func(x, y int) bool {
+		return math.Hypot(float64(x)+0.5-cx, (float64(y)+0.5-cy)*2) < radius
+	})
+}
+
+// transition is a transition in progress
+type transition struct {
+	Transition
+	from    []entry
+	elapsed float64
+
+	// Offscreen buffers the outgoing and incoming scenes draw into
+	fromBuffer *render.Renderer
+	toBuffer   *render.Renderer
+}
+
+// progress returns the eased progress of the transition
+func (t *transition) progress() float64 {
+	progress := 1.0
+	if t.Duration > 0 {
+		progress = timing.Clamp(t.elapsed / t.Duration)
+	}
+	if t.Easing != nil {
+		progress = t.Easing(progress)
+	}
+	return progress
+}
+
+// done reports whether the transition has finished
+func (t *transition) done() bool {
+	return t.elapsed >= t.Duration
+}
+
+// buffers returns offscreen renderers the size of dst, recreating them if
+// its size changed
+func (t *transition) buffers(dst *render.Renderer) (*render.Renderer, *render.Renderer) {
+	width, height := dst.Size()
+	if t.fromBuffer == nil || !sameSize(t.fromBuffer, width, height) {
+		t.fromBuffer = offscreen(dst)
+		t.toBuffer = offscreen(dst)
+	}
+	t.fromBuffer.Clear()
+	t.toBuffer.Clear()
+	return t.fromBuffer, t.toBuffer
+}
+
+// offscreen creates a renderer like dst that never presents
+func offscreen(dst *render.Renderer) *render.Renderer {
+	width, height := dst.Size()
+	r := render.NewRenderer(width, height, dst.Palette())
+	r.SetBackend(render.NullBackend{})
+	return r
+}
+
+func sameSize(r *render.Renderer, width, height int) bool {
+	w, h := r.Size()
+	return w == width && h == height
+}
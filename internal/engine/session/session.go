@@ -0,0 +1,102 @@
+// Package session logs a summary of every game played, built from the
+// gameplay events the games publish
+package session
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/kuhree/gg/internal/engine/events"
+)
+
+// Log follows the gameplay events on a bus and logs each game as it starts
+// and ends
+type Log struct {
+	logger        *slog.Logger
+	subscriptions []events.Handle
+
+	// The game in progress
+	playing   bool
+	game      string
+	started   time.Time
+	score     int
+	level     int
+	livesLost int
+	powerUps  int
+}
+
+// Record starts logging the games published on bus
+func Record(bus *events.Bus, logger *slog.Logger) *Log {
+	l := &Log{logger: logger}
+	l.subscriptions = []events.Handle{
+		events.Subscribe(bus, l.onGameStarted),
+		events.Subscribe(bus, l.onScoreChanged),
+		events.Subscribe(bus, l.onLevelChanged),
+		events.Subscribe(bus, l.onLifeLost),
+		events.Subscribe(bus, l.onPowerUpCollected),
+		events.Subscribe(bus, l.onGameOver),
+	}
+	return l
+}
+
+// Stop stops logging, summarizing the game in progress if there is one
+func (l *Log) Stop() {
+	for _, subscription := range l.subscriptions {
+		subscription.Unsubscribe()
+	}
+	l.subscriptions = nil
+
+	if l.playing {
+		l.summarize("Game abandoned", "")
+	}
+}
+
+func (l *Log) onGameStarted(e events.GameStarted) {
+	*l = Log{
+		logger:        l.logger,
+		subscriptions: l.subscriptions,
+		playing:       true,
+		game:          e.Game,
+		started:       time.Now(),
+		score:         e.Score,
+		level:         e.Level,
+	}
+	l.logger.Info("Game started", "game", e.Game, "score", e.Score, "level", e.Level)
+}
+
+func (l *Log) onScoreChanged(e events.ScoreChanged) {
+	l.score = e.Score
+}
+
+func (l *Log) onLevelChanged(e events.LevelChanged) {
+	l.level = e.Level
+}
+
+func (l *Log) onLifeLost(e events.LifeLost) {
+	l.livesLost++
+	l.logger.Info("Life lost", "game", l.game, "lives", e.Lives)
+}
+
+func (l *Log) onPowerUpCollected(e events.PowerUpCollected) {
+	l.powerUps++
+	l.logger.Info("Power-up collected", "game", l.game, "powerUp", e.Name)
+}
+
+func (l *Log) onGameOver(e events.GameOver) {
+	l.score, l.level = e.Score, e.Level
+	l.summarize("Game over", e.Reason)
+	l.playing = false
+}
+
+// summarize logs the game in progress
+func (l *Log) summarize(msg, reason string) {
+	l.logger.Info(msg,
+		"game", l.game,
+		"reason", reason,
+		"score", l.score,
+		"level", l.level,
+		"duration", time.Since(l.started).Round(time.Second),
+		"livesLost", l.livesLost,
+		"powerUps", l.powerUps,
+	)
+}
@@ -0,0 +1,55 @@
+// Package timing provides timers, tweens, sequences and easing curves.
+// Everything advances by the dt passed to Update, so driving it from a
+// scene's Update pauses it together with the scene.
+package timing
+
+import "math"
+
+// Easing maps linear progress from 0 to 1 onto a curve that also starts at 0
+// and ends at 1
+type Easing func(t float64) float64
+
+// Linear moves at a constant rate
+func Linear(t float64) float64 {
+	return t
+}
+
+// EaseIn starts slow and speeds up
+func EaseIn(t float64) float64 {
+	return t * t
+}
+
+// EaseOut starts fast and slows down
+func EaseOut(t float64) float64 {
+	return 1 - (1-t)*(1-t)
+}
+
+// EaseInOut starts and ends slow
+func EaseInOut(t float64) float64 {
+	if t < 0.5 {
+		return 2 * t * t
+	}
+	return 1 - math.Pow(-2*t+2, 2)/2
+}
+
+// EaseOutBack overshoots the end a little before settling
+func EaseOutBack(t float64) float64 {
+	const c1 = 1.70158
+	const c3 = c1 + 1
+	return 1 + c3*math.Pow(t-1, 3) + c1*math.Pow(t-1, 2)
+}
+
+// Steps quantizes progress into n equal steps, for a choppy retro look
+func Steps(n int) Easing {
+	return func(t float64) float64 {
+		if t >= 1 {
+			return 1
+		}
+		return math.Floor(t*float64(n)) / float64(n)
+	}
+}
+
+// Clamp limits progress to 0..1
+func Clamp(t float64) float64 {
+	return math.Max(0, math.Min(t, 1))
+}
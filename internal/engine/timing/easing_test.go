@@ -0,0 +1,49 @@
+package timing
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEasingEnds(t *testing.T) {
+	easings := map[string]Easing{
+		"Linear":      Linear,
+		"EaseIn":      EaseIn,
+		"EaseOut":     EaseOut,
+		"EaseInOut":   EaseInOut,
+		"EaseOutBack": EaseOutBack,
+		"Steps(4)":    Steps(4),
+	}
+	for name, easing := range easings {
+		if got := easing(0); math.Abs(got) > 1e-9 {
+			t.Errorf("%s(0) = %v, want 0", name, got)
+		}
+		if got := easing(1); math.Abs(got-1) > 1e-9 {
+			t.Errorf("%s(1) = %v, want 1", name, got)
+		}
+	}
+}
+
+func TestEasingCurves(t *testing.T) {
+	tests := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{"EaseIn(0.5)", EaseIn(0.5), 0.25},
+		{"EaseOut(0.5)", EaseOut(0.5), 0.75},
+		{"EaseInOut(0.5)", EaseInOut(0.5), 0.5},
+		{"Steps(4)(0.3)", Steps(4)(0.3), 0.25},
+		{"Steps(4)(0.99)", Steps(4)(0.99), 0.75},
+		{"Clamp(-1)", Clamp(-1), 0},
+		{"Clamp(2)", Clamp(2), 1},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+	if EaseOutBack(0.8) <= 1 {
+		t.Errorf("EaseOutBack(0.8) = %v, want an overshoot past 1", EaseOutBack(0.8))
+	}
+}
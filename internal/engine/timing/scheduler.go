@@ -0,0 +1,90 @@
+package timing
+
+// task is something the scheduler updates, it reports when it's finished
+type task func(dt float64) bool
+
+// Scheduler updates timers, tweens and sequences from a single Update,
+// usually a scene's, dropping them once they're done. They pause whenever
+// the scheduler isn't updated, e.g. while a pause menu is on top.
+type Scheduler struct {
+	tasks []task
+}
+
+// NewScheduler creates an empty scheduler
+func NewScheduler() *Scheduler {
+	return &Scheduler{}
+}
+
+// After schedules fn to be called once after duration seconds
+func (s *Scheduler) After(duration float64, fn func()) *Timer {
+	timer := After(duration, fn)
+	s.add(func(dt float64) bool {
+		timer.Update(dt)
+		return timer.Done()
+	})
+	return timer
+}
+
+// Every schedules fn to be called every interval seconds until the returned
+// timer is stopped
+func (s *Scheduler) Every(interval float64, fn func()) *Timer {
+	timer := Every(interval, fn)
+	s.add(func(dt float64) bool {
+		timer.Update(dt)
+		return timer.Done()
+	})
+	return timer
+}
+
+// Tween schedules a tween of target to to over duration seconds
+func (s *Scheduler) Tween(target *float64, to, duration float64, easing Easing) *Tween {
+	tween := NewTween(target, to, duration, easing)
+	s.add(func(dt float64) bool {
+		tween.Update(dt)
+		return tween.Done()
+	})
+	return tween
+}
+
+// Sequence schedules a new, empty sequence to add steps to
+func (s *Scheduler) Sequence() *Sequence {
+	sequence := NewSequence()
+	s.add(func(dt float64) bool {
+		sequence.Update(dt)
+		return sequence.Done()
+	})
+	return sequence
+}
+
+func (s *Scheduler) add(t task) {
+	s.tasks = append(s.tasks, t)
+}
+
+// Update advances every task. Tasks scheduled while updating start with the
+// next Update.
+func (s *Scheduler) Update(dt float64) {
+	n := len(s.tasks)
+	for i := 0; i < n && i < len(s.tasks); i++ {
+		if s.tasks[i](dt) {
+			s.tasks[i] = nil
+		}
+	}
+
+	tasks := s.tasks[:0]
+	for _, t := range s.tasks {
+		if t != nil {
+			tasks = append(tasks, t)
+		}
+	}
+	s.tasks = tasks
+}
+
+// Active reports whether any task is still running
+func (s *Scheduler) Active() bool {
+	return len(s.tasks) > 0
+}
+
+// Clear drops every task
+func (s *Scheduler) Clear() {
+	s.tasks = nil
+}
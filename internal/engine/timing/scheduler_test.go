@@ -0,0 +1,66 @@
+package timing
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestScheduler(t *testing.T) {
+	s := NewScheduler()
+	var got []string
+	s.After(1, func() { got = append(got, "after") })
+	ticker := s.Every(0.5, func() { got = append(got, "every") })
+	value := 0.0
+	s.Tween(&value, 4, 1, nil)
+
+	s.Update(0.5)
+	s.Update(0.5)
+	// Tasks update in the order they were scheduled
+	if want := []string{"every", "after", "every"}; !slices.Equal(got, want) {
+		t.Errorf("fired %v, want %v", got, want)
+	}
+	if value != 4 {
+		t.Errorf("tween value = %v, want 4", value)
+	}
+
+	// Finished tasks are dropped, the repeating timer stays until stopped
+	ticker.Stop()
+	s.Update(0.5)
+	if s.Active() {
+		t.Error("scheduler still active after every task finished")
+	}
+}
+
+func TestSchedulerAddWhileUpdating(t *testing.T) {
+	s := NewScheduler()
+	fired := false
+	s.After(0, func() {
+		s.After(0, func() { fired = true })
+	})
+
+	s.Update(1)
+	if fired {
+		t.Error("task scheduled while updating ran in the same Update")
+	}
+	s.Update(1)
+	if !fired {
+		t.Error("task scheduled while updating never ran")
+	}
+}
+
+func TestSchedulerClear(t *testing.T) {
+	s := NewScheduler()
+	fired := 0
+	s.After(1, func() { fired++ })
+	s.Every(1, func() { fired++ })
+	s.Sequence().Wait(1).Do(func() { fired++ })
+
+	s.Clear()
+	if s.Active() {
+		t.Error("Active after Clear")
+	}
+	s.Update(2)
+	if fired != 0 {
+		t.Errorf("cleared tasks fired %d times", fired)
+	}
+}
@@ -0,0 +1,84 @@
+package timing
+
+// step is one step of a sequence. It runs for dt seconds and returns the time
+// left over once it has finished, so the next step can use it.
+type step func(dt float64) (float64, bool)
+
+// Sequence runs steps one after another for scripted effects, e.g. wait a
+// second, flash the screen, then spawn the boss:
+//
+//	NewSequence().Wait(1).Do(flash).Do(spawnBoss)
+//
+// Steps that take no time run in the same Update as the step before them.
+type Sequence struct {
+	steps   []step
+	current int
+}
+
+// NewSequence creates an empty sequence
+func NewSequence() *Sequence {
+	return &Sequence{}
+}
+
+// Wait adds a pause of the given seconds
+func (s *Sequence) Wait(seconds float64) *Sequence {
+	elapsed := 0.0
+	return s.then(func(dt float64) (float64, bool) {
+		elapsed += dt
+		if elapsed < seconds {
+			return 0, false
+		}
+		return elapsed - seconds, true
+	})
+}
+
+// Do adds a call to fn
+func (s *Sequence) Do(fn func()) *Sequence {
+	return s.then(func(dt float64) (float64, bool) {
+		fn()
+		return dt, true
+	})
+}
+
+// Until adds a pause that lasts until cond returns true
+func (s *Sequence) Until(cond func() bool) *Sequence {
+	return s.then(func(dt float64) (float64, bool) {
+		if cond() {
+			return dt, true
+		}
+		return 0, false
+	})
+}
+
+// Tween adds a tween of target to to over duration seconds
+func (s *Sequence) Tween(target *float64, to, duration float64, easing Easing) *Sequence {
+	return s.then(NewTween(target, to, duration, easing).advance)
+}
+
+// then appends a step
+func (s *Sequence) then(next step) *Sequence {
+	s.steps = append(s.steps, next)
+	return s
+}
+
+// Update runs the current step, moving on to the next ones as they finish
+func (s *Sequence) Update(dt float64) {
+	for s.current < len(s.steps) {
+		left, done := s.steps[s.current](dt)
+		if !done {
+			return
+		}
+		s.current++
+		dt = left
+	}
+}
+
+// Done reports whether every step has finished
+func (s *Sequence) Done() bool {
+	return s.current >= len(s.steps)
+}
+
+// Stop skips the remaining steps
+func (s *Sequence) Stop() {
+	s.current = len(s.steps)
+}
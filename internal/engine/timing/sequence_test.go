@@ -0,0 +1,62 @@
+package timing
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSequence(t *testing.T) {
+	var got []string
+	ready := false
+	value := 0.0
+	sequence := NewSequence().
+		Do(func() { got = append(got, "start") }).
+		Wait(1).
+		Do(func() { got = append(got, "waited") }).
+		Until(func() bool { return ready }).
+		Tween(&value, 10, 1, nil).
+		Do(func() { got = append(got, "tweened") })
+
+	// Steps that take no time run right away
+	sequence.Update(0.5)
+	if want := []string{"start"}; !slices.Equal(got, want) {
+		t.Errorf("after 0.5s ran %v, want %v", got, want)
+	}
+
+	// Time left over from the wait isn't lost, but Until holds the rest
+	sequence.Update(0.75)
+	if want := []string{"start", "waited"}; !slices.Equal(got, want) {
+		t.Errorf("after 1.25s ran %v, want %v", got, want)
+	}
+	sequence.Update(5)
+	if value != 0 || sequence.Done() {
+		t.Errorf("sequence moved past Until before its condition held")
+	}
+
+	ready = true
+	sequence.Update(0.5)
+	if value != 5 {
+		t.Errorf("tween halfway = %v, want 5", value)
+	}
+	sequence.Update(0.75)
+	if want := []string{"start", "waited", "tweened"}; !slices.Equal(got, want) {
+		t.Errorf("ran %v, want %v", got, want)
+	}
+	if value != 10 || !sequence.Done() {
+		t.Errorf("value, Done = %v, %v, want 10, true", value, sequence.Done())
+	}
+}
+
+func TestSequenceStop(t *testing.T) {
+	fired := false
+	sequence := NewSequence().Wait(1).Do(func() { fired = true })
+
+	sequence.Stop()
+	sequence.Update(2)
+	if fired || !sequence.Done() {
+		t.Errorf("stopped sequence fired %v, done %v, want false, true", fired, sequence.Done())
+	}
+	if !NewSequence().Done() {
+		t.Error("empty sequence isn't done")
+	}
+}
@@ -0,0 +1,90 @@
+package timing
+
+import "math"
+
+// Timer fires after Duration seconds. A one-shot timer then stops, a
+// repeating one keeps firing every Duration seconds.
+type Timer struct {
+	Duration float64
+	Repeat   bool
+	// OnFire is called every time the timer fires, it may be nil
+	OnFire func()
+
+	elapsed float64
+	stopped bool
+}
+
+// After creates a one-shot timer calling fn after duration seconds
+func After(duration float64, fn func()) *Timer {
+	return &Timer{Duration: duration, OnFire: fn}
+}
+
+// Every creates a repeating timer calling fn every interval seconds
+func Every(interval float64, fn func()) *Timer {
+	return &Timer{Duration: interval, Repeat: true, OnFire: fn}
+}
+
+// Update advances the timer and reports whether it fired. A timer fires at
+// most once per Update, time past the interval carries over to the next one.
+func (t *Timer) Update(dt float64) bool {
+	if t == nil || t.stopped {
+		return false
+	}
+
+	t.elapsed += dt
+	if t.elapsed < t.Duration {
+		return false
+	}
+
+	switch {
+	case !t.Repeat:
+		t.stopped = true
+	case t.Duration > 0:
+		t.elapsed = math.Mod(t.elapsed, t.Duration)
+	default:
+		t.elapsed = 0
+	}
+
+	if t.OnFire != nil {
+		t.OnFire()
+	}
+	return true
+}
+
+// Reset restarts the timer from zero, also restarting a stopped one
+func (t *Timer) Reset() {
+	t.elapsed = 0
+	t.stopped = false
+}
+
+// Stop stops the timer without firing it
+func (t *Timer) Stop() {
+	t.stopped = true
+}
+
+// Done reports whether the timer has stopped, either because a one-shot
+// timer fired or because it was stopped
+func (t *Timer) Done() bool {
+	return t.stopped
+}
+
+// Elapsed returns the seconds since the timer was started or last fired
+func (t *Timer) Elapsed() float64 {
+	return t.elapsed
+}
+
+// Remaining returns the seconds until the timer fires next
+func (t *Timer) Remaining() float64 {
+	if t.stopped {
+		return 0
+	}
+	return math.Max(t.Duration-t.elapsed, 0)
+}
+
+// Progress returns how far the timer is towards firing, 0 to 1
+func (t *Timer) Progress() float64 {
+	if t.stopped || t.Duration <= 0 {
+		return 1
+	}
+	return Clamp(t.elapsed / t.Duration)
+}
@@ -0,0 +1,73 @@
+package timing
+
+import "testing"
+
+func TestTimerAfter(t *testing.T) {
+	fired := 0
+	timer := After(1, func() { fired++ })
+
+	for i, tt := range []struct {
+		dt    float64
+		fires bool
+	}{
+		{0.5, false},
+		{0.75, true},
+		{2, false},
+	} {
+		if got := timer.Update(tt.dt); got != tt.fires {
+			t.Errorf("Update %d (%v) = %v, want %v", i, tt.dt, got, tt.fires)
+		}
+	}
+	if fired != 1 || !timer.Done() {
+		t.Errorf("fired %d times, done %v, want once and done", fired, timer.Done())
+	}
+	if timer.Remaining() != 0 || timer.Progress() != 1 {
+		t.Errorf("Remaining, Progress = %v, %v, want 0, 1", timer.Remaining(), timer.Progress())
+	}
+
+	timer.Reset()
+	if timer.Done() || !timer.Update(1) || fired != 2 {
+		t.Error("Reset didn't restart the timer")
+	}
+}
+
+func TestTimerEvery(t *testing.T) {
+	fired := 0
+	timer := Every(1, func() { fired++ })
+
+	for i, tt := range []struct {
+		dt      float64
+		fires   bool
+		elapsed float64
+	}{
+		{0.5, false, 0.5},
+		// The overshoot carries over to the next interval
+		{0.75, true, 0.25},
+		{0.5, false, 0.75},
+		// Firing at most once per Update, keeping the time past the interval
+		{2.5, true, 0.25},
+		{0.75, true, 0},
+	} {
+		if got := timer.Update(tt.dt); got != tt.fires {
+			t.Errorf("Update %d (%v) = %v, want %v", i, tt.dt, got, tt.fires)
+		}
+		if got := timer.Elapsed(); got != tt.elapsed {
+			t.Errorf("Elapsed after update %d = %v, want %v", i, got, tt.elapsed)
+		}
+	}
+	if fired != 3 || timer.Done() {
+		t.Errorf("fired %d times, done %v, want 3 times and still running", fired, timer.Done())
+	}
+
+	timer.Stop()
+	if timer.Update(5) || !timer.Done() || fired != 3 {
+		t.Error("stopped timer fired")
+	}
+}
+
+func TestTimerNil(t *testing.T) {
+	var timer *Timer
+	if timer.Update(1) {
+		t.Error("nil timer fired")
+	}
+}
@@ -0,0 +1,62 @@
+package timing
+
+// Tween animates a float field to To over Duration seconds, starting from
+// whatever value the field holds when the tween first updates
+type Tween struct {
+	Target   *float64
+	To       float64
+	Duration float64
+	// Easing shapes the progress, nil is linear
+	Easing Easing
+
+	from    float64
+	elapsed float64
+	started bool
+	done    bool
+}
+
+// NewTween creates a tween moving target to to over duration seconds
+func NewTween(target *float64, to, duration float64, easing Easing) *Tween {
+	return &Tween{Target: target, To: to, Duration: duration, Easing: easing}
+}
+
+// Update advances the tween and writes the new value to the target
+func (t *Tween) Update(dt float64) {
+	t.advance(dt)
+}
+
+// advance runs the tween for dt seconds and returns the time left over once
+// it has finished
+func (t *Tween) advance(dt float64) (float64, bool) {
+	if t.done {
+		return dt, true
+	}
+	if !t.started {
+		t.from = *t.Target
+		t.started = true
+	}
+
+	t.elapsed += dt
+	if t.elapsed >= t.Duration {
+		*t.Target = t.To
+		t.done = true
+		return t.elapsed - t.Duration, true
+	}
+
+	progress := Clamp(t.elapsed / t.Duration)
+	if t.Easing != nil {
+		progress = t.Easing(progress)
+	}
+	*t.Target = t.from + (t.To-t.from)*progress
+	return 0, false
+}
+
+// Done reports whether the tween has reached its end value
+func (t *Tween) Done() bool {
+	return t.done
+}
+
+// Stop ends the tween, leaving the target at its current value
+func (t *Tween) Stop() {
+	t.done = true
+}
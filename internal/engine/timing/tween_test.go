@@ -0,0 +1,38 @@
+package timing
+
+import "testing"
+
+func TestTween(t *testing.T) {
+	value := 2.0
+	tween := NewTween(&value, 10, 2, EaseIn)
+
+	// The tween starts from the value the target holds on the first update
+	value = 6
+	tween.Update(1)
+	if value != 7 {
+		t.Errorf("value halfway = %v, want 7", value)
+	}
+
+	// Overshooting lands exactly on the end value
+	tween.Update(1.5)
+	if value != 10 || !tween.Done() {
+		t.Errorf("value, Done = %v, %v, want 10, true", value, tween.Done())
+	}
+
+	value = 3
+	tween.Update(1)
+	if value != 3 {
+		t.Errorf("finished tween changed the value to %v", value)
+	}
+}
+
+func TestTweenStop(t *testing.T) {
+	value := 0.0
+	tween := NewTween(&value, 8, 4, nil)
+	tween.Update(1)
+	tween.Stop()
+	tween.Update(1)
+	if value != 2 || !tween.Done() {
+		t.Errorf("value, Done = %v, %v, want 2, true", value, tween.Done())
+	}
+}
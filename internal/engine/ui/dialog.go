@@ -0,0 +1,70 @@
+package ui
+
+import (
+	"github.com/kuhree/gg/internal/engine/core"
+	"github.com/kuhree/gg/internal/engine/render"
+)
+
+// Dialog is a modal box with a title, a wrapped message and a menu of choices
+type Dialog struct {
+	Title   string
+	Message string
+	Menu    *Menu
+	// Width of the box in cells, 0 uses two thirds of the area
+	Width int
+}
+
+// NewDialog creates a dialog offering the given choices
+func NewDialog(title, message string, choices ...MenuItem) *Dialog {
+	return &Dialog{
+		Title:   title,
+		Message: message,
+		Menu:    NewMenu(choices...),
+	}
+}
+
+// Draw clears a box in the middle of area and draws the dialog inside it
+func (d *Dialog) Draw(r *render.Renderer, area render.Rect) {
+	width := d.Width
+	if width <= 0 {
+		width = area.Width * 2 / 3
+	}
+	width = min(width, area.Width)
+
+	lines := render.Wrap(d.Message, width-4)
+	height := min(len(lines)+len(d.Menu.Items)+5, area.Height)
+	box := Center(area, width, height)
+
+	DrawBox(r, box, r.Color(render.RoleHeading))
+	if d.Title != "" {
+		_ = r.DrawTextAligned(" "+d.Title+" ", box.X+1, box.Y, box.Width-2, render.AlignCenter, r.Color(render.RoleTitle))
+	}
+
+	inner := Inset(box, 2, 1)
+	rows := Rows(inner, 1, len(lines), 0)
+	_, _ = r.DrawTextBox(d.Message, rows[0], render.AlignLeft, r.Color(render.RoleText))
+	d.Menu.Draw(r, rows[1])
+}
+
+// HandleInput passes input to the dialog's menu
+func (d *Dialog) HandleInput(input core.InputEvent) (bool, error) {
+	return d.Menu.HandleInput(input)
+}
+
+// DrawBox draws a single line border around area and clears its inside
+func DrawBox(r *render.Renderer, area render.Rect, color render.Color) {
+	if area.Width < 2 || area.Height < 2 {
+		return
+	}
+
+	right, bottom := area.X+area.Width-1, area.Y+area.Height-1
+	_ = r.DrawRect(area.X+1, area.Y+1, area.Width-2, area.Height-2, ' ', color)
+	_ = r.DrawRect(area.X+1, area.Y, area.Width-2, 1, '─', color)
+	_ = r.DrawRect(area.X+1, bottom, area.Width-2, 1, '─', color)
+	_ = r.DrawRect(area.X, area.Y+1, 1, area.Height-2, '│', color)
+	_ = r.DrawRect(right, area.Y+1, 1, area.Height-2, '│', color)
+	_ = r.DrawChar('┌', area.X, area.Y, color)
+	_ = r.DrawChar('┐', right, area.Y, color)
+	_ = r.DrawChar('└', area.X, bottom, color)
+	_ = r.DrawChar('┘', right, bottom, color)
+}
@@ -0,0 +1,120 @@
+package ui
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/kuhree/gg/internal/engine/core"
+	"github.com/kuhree/gg/internal/engine/render"
+)
+
+// Control keys understood by TextInput, as sent by a raw terminal
+const (
+	keyHome    = rune(1)  // Ctrl+A
+	keyBack    = rune(2)  // Ctrl+B
+	keyEnd     = rune(5)  // Ctrl+E
+	keyForward = rune(6)  // Ctrl+F
+	keyKill    = rune(21) // Ctrl+U
+)
+
+// TextInput is a single line text field. Printable keys insert at the
+// cursor, Backspace deletes before it, Ctrl+B/Ctrl+F move it, Ctrl+A/Ctrl+E
+// jump to the start or end and Ctrl+U clears the field.
+type TextInput struct {
+	Focusable
+	// MaxLength limits the number of runes, 0 is unlimited
+	MaxLength int
+	// Blink blinks the cursor, nil keeps it steady
+	Blink *Blink
+	// OnSubmit runs when Enter is pressed
+	OnSubmit func(value string) error
+
+	value  []rune
+	cursor int
+}
+
+// NewTextInput creates a focused, empty text input
+func NewTextInput(maxLength int) *TextInput {
+	t := &TextInput{MaxLength: maxLength}
+	t.SetFocused(true)
+	return t
+}
+
+// Value returns the current text
+func (t *TextInput) Value() string {
+	return string(t.value)
+}
+
+// SetValue replaces the text and moves the cursor to its end
+func (t *TextInput) SetValue(value string) {
+	t.value = []rune(value)
+	if t.MaxLength > 0 && len(t.value) > t.MaxLength {
+		t.value = t.value[:t.MaxLength]
+	}
+	t.cursor = len(t.value)
+}
+
+// Cursor returns the cursor position in runes
+func (t *TextInput) Cursor() int {
+	return t.cursor
+}
+
+// Draw draws the text with a cursor while focused
+func (t *TextInput) Draw(r *render.Renderer, area render.Rect) {
+	if area.Empty() {
+		return
+	}
+
+	color := r.Color(render.RoleAccent)
+	x := area.X
+	for i, char := range t.value {
+		width := render.RuneWidth(char)
+		if t.Focused() && i == t.cursor && t.Blink.On() {
+			// The cursor covers every column of a wide rune
+			_ = r.DrawText("_"+strings.Repeat(" ", max(width-1, 0)), x, area.Y, color)
+		} else {
+			_ = r.DrawChar(char, x, area.Y, color)
+		}
+		x += width
+	}
+	if t.Focused() && t.cursor == len(t.value) && t.Blink.On() {
+		_ = r.DrawChar('_', x, area.Y, color)
+	}
+}
+
+// HandleInput edits the text
+func (t *TextInput) HandleInput(input core.InputEvent) (bool, error) {
+	switch input.Rune {
+	case core.KeyEnter:
+		if t.OnSubmit != nil {
+			return true, t.OnSubmit(t.Value())
+		}
+	case core.KeyBackspace, '\b':
+		if t.cursor > 0 {
+			t.value = append(t.value[:t.cursor-1], t.value[t.cursor:]...)
+			t.cursor--
+		}
+	case keyBack:
+		t.cursor = max(t.cursor-1, 0)
+	case keyForward:
+		t.cursor = min(t.cursor+1, len(t.value))
+	case keyHome:
+		t.cursor = 0
+	case keyEnd:
+		t.cursor = len(t.value)
+	case keyKill:
+		t.value, t.cursor = t.value[:0], 0
+	default:
+		if !unicode.IsPrint(input.Rune) {
+			return false, nil
+		}
+		if t.MaxLength > 0 && len(t.value) >= t.MaxLength {
+			return true, nil
+		}
+		t.value = append(t.value[:t.cursor], append([]rune{input.Rune}, t.value[t.cursor:]...)...)
+		t.cursor++
+	}
+
+	t.Blink.Reset()
+	return true, nil
+}
@@ -0,0 +1,39 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/kuhree/gg/internal/engine/render"
+)
+
+func TestTextInputDrawWideRunes(t *testing.T) {
+	// Rows leave out the second column of wide runes
+	tests := []struct {
+		value  string
+		cursor int
+		want   string
+	}{
+		{"ab", 2, "ab_     "},
+		{"ab", 0, "_b      "},
+		{"你好c", 3, "你好c_  "},
+		{"你好c", 0, "_ 好c   "},
+		{"你好c", 1, "你_ c   "},
+		{"你好c", 2, "你好_   "},
+	}
+	for _, tt := range tests {
+		input := NewTextInput(0)
+		input.SetValue(tt.value)
+		input.cursor = tt.cursor
+
+		r := render.NewRenderer(8, 1, render.DefaultPalette)
+		frame := render.NewMemoryBackend()
+		r.SetBackend(frame)
+		input.Draw(r, render.Rect{Width: 8, Height: 1})
+		if err := r.Render(); err != nil {
+			t.Fatal(err)
+		}
+		if got := frame.Row(0); got != tt.want {
+			t.Errorf("%q with the cursor at %d drew %q, want %q", tt.value, tt.cursor, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package ui
+
+import "github.com/kuhree/gg/internal/engine/render"
+
+// Screen returns the area covering a whole renderer
+func Screen(r *render.Renderer) render.Rect {
+	width, height := r.Size()
+	return render.Rect{Width: width, Height: height}
+}
+
+// Inset shrinks an area by dx columns on the left and right and dy rows on
+// the top and bottom
+func Inset(area render.Rect, dx, dy int) render.Rect {
+	return render.Rect{
+		X:      area.X + dx,
+		Y:      area.Y + dy,
+		Width:  max(area.Width-2*dx, 0),
+		Height: max(area.Height-2*dy, 0),
+	}
+}
+
+// Center returns a width x height area centered inside area
+func Center(area render.Rect, width, height int) render.Rect {
+	return render.Rect{
+		X:      area.X + (area.Width-width)/2,
+		Y:      area.Y + (area.Height-height)/2,
+		Width:  width,
+		Height: height,
+	}
+}
+
+// From returns the part of area starting fraction of the way down, e.g.
+// From(area, 1.0/4) starts a quarter of the way down
+func From(area render.Rect, fraction float64) render.Rect {
+	offset := int(float64(area.Height) * fraction)
+	return render.Rect{
+		X:      area.X,
+		Y:      area.Y + offset,
+		Width:  area.Width,
+		Height: max(area.Height-offset, 0),
+	}
+}
+
+// Rows splits area into consecutive rows of the given heights separated by
+// gap. A height of 0 shares whatever space the fixed rows leave over.
+func Rows(area render.Rect, gap int, heights ...int) []render.Rect {
+	fixed, flexible := gap*max(len(heights)-1, 0), 0
+	for _, h := range heights {
+		if h == 0 {
+			flexible++
+		}
+		fixed += h
+	}
+
+	share := 0
+	if flexible > 0 {
+		share = max(area.Height-fixed, 0) / flexible
+	}
+
+	rows := make([]render.Rect, len(heights))
+	y := area.Y
+	for i, h := range heights {
+		if h == 0 {
+			h = share
+		}
+		rows[i] = render.Rect{X: area.X, Y: y, Width: area.Width, Height: h}
+		y += h + gap
+	}
+	return rows
+}
@@ -0,0 +1,102 @@
+package ui
+
+import (
+	"github.com/kuhree/gg/internal/engine/core"
+	"github.com/kuhree/gg/internal/engine/render"
+)
+
+// MenuItem is one selectable entry of a Menu
+type MenuItem struct {
+	Label string
+	// Keys activate the item directly, regardless of the selection
+	Keys []rune
+	// Action runs when the item is activated, it may be nil
+	Action func() error
+}
+
+// Menu is a vertical list of items. W/K and S/J move the selection,
+// Enter activates it.
+type Menu struct {
+	Focusable
+	Items    []MenuItem
+	Selected int
+	// Spacing is the number of rows from one item to the next, at least 1
+	Spacing int
+	// Blink blinks the selected item, nil keeps it steady
+	Blink *Blink
+}
+
+// NewMenu creates a focused menu with the first item selected
+func NewMenu(items ...MenuItem) *Menu {
+	m := &Menu{Items: items}
+	m.SetFocused(true)
+	return m
+}
+
+// Height returns the number of rows the menu needs
+func (m *Menu) Height() int {
+	if len(m.Items) == 0 {
+		return 0
+	}
+	return (len(m.Items)-1)*max(m.Spacing, 1) + 1
+}
+
+// Draw draws one item per row, marking the selected one
+func (m *Menu) Draw(r *render.Renderer, area render.Rect) {
+	spacing := max(m.Spacing, 1)
+	for i, item := range m.Items {
+		y := area.Y + i*spacing
+		if y >= area.Y+area.Height {
+			return
+		}
+
+		label, color := "  "+item.Label, r.Color(render.RoleText)
+		if i == m.Selected && m.Focused() {
+			color = r.Color(render.RoleAccent)
+			if m.Blink.On() {
+				label = "> " + item.Label
+			}
+		}
+		_ = r.DrawTextAligned(label, area.X, y, area.Width, render.AlignLeft, color)
+	}
+}
+
+// HandleInput moves the selection and runs item actions
+func (m *Menu) HandleInput(input core.InputEvent) (bool, error) {
+	for i, item := range m.Items {
+		for _, key := range item.Keys {
+			if key == input.Rune {
+				m.Selected = i
+				return true, m.activate(i)
+			}
+		}
+	}
+
+	switch input.Rune {
+	case 'w', 'W', 'k', 'K':
+		m.Move(-1)
+	case 's', 'S', 'j', 'J':
+		m.Move(1)
+	case core.KeyEnter:
+		return true, m.activate(m.Selected)
+	default:
+		return false, nil
+	}
+	return true, nil
+}
+
+// Move moves the selection by delta items, wrapping around
+func (m *Menu) Move(delta int) {
+	if len(m.Items) == 0 {
+		return
+	}
+	m.Selected = ((m.Selected+delta)%len(m.Items) + len(m.Items)) % len(m.Items)
+	m.Blink.Reset()
+}
+
+func (m *Menu) activate(i int) error {
+	if i < 0 || i >= len(m.Items) || m.Items[i].Action == nil {
+		return nil
+	}
+	return m.Items[i].Action()
+}
@@ -0,0 +1,103 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kuhree/gg/internal/engine/render"
+)
+
+// Table draws rows of cells in aligned columns under an optional header
+type Table struct {
+	Headers []string
+	Rows    [][]string
+	// Separator goes between columns, defaults to " | "
+	Separator string
+	// Spacing is the number of rows from one table row to the next, at least 1
+	Spacing int
+}
+
+// Widths returns the display width of every column
+func (t *Table) Widths() []int {
+	var widths []int
+	for _, row := range append([][]string{t.Headers}, t.Rows...) {
+		for i, cell := range row {
+			if i >= len(widths) {
+				widths = append(widths, 0)
+			}
+			widths[i] = max(widths[i], render.StringWidth(cell))
+		}
+	}
+	return widths
+}
+
+// Draw draws the header and as many rows as fit, truncating the last column
+func (t *Table) Draw(r *render.Renderer, area render.Rect) {
+	widths := t.Widths()
+	spacing := max(t.Spacing, 1)
+	y := area.Y
+
+	if len(t.Headers) > 0 {
+		_ = r.DrawTextAligned(t.line(t.Headers, widths), area.X, y, area.Width, render.AlignLeft, r.Color(render.RoleHeading))
+		y += spacing
+	}
+	for _, row := range t.Rows {
+		if y >= area.Y+area.Height {
+			return
+		}
+		_ = r.DrawTextAligned(t.line(row, widths), area.X, y, area.Width, render.AlignLeft, r.Color(render.RoleText))
+		y += spacing
+	}
+}
+
+// line pads every cell but the last to its column width
+func (t *Table) line(row []string, widths []int) string {
+	separator := t.Separator
+	if separator == "" {
+		separator = " | "
+	}
+
+	var sb strings.Builder
+	for i, cell := range row {
+		if i > 0 {
+			sb.WriteString(separator)
+		}
+		sb.WriteString(cell)
+		if i < len(row)-1 {
+			sb.WriteString(strings.Repeat(" ", widths[i]-render.StringWidth(cell)))
+		}
+	}
+	return sb.String()
+}
+
+// ProgressBar shows a value between 0 and 1 as a filled bar and percentage
+type ProgressBar struct {
+	Value float64
+	Label string
+	// Role colors the filled part, defaults to accent
+	Role string
+}
+
+// Draw draws "Label [████░░░░] 50%" on the first row of area
+func (p *ProgressBar) Draw(r *render.Renderer, area render.Rect) {
+	value := min(max(p.Value, 0), 1)
+	percent := fmt.Sprintf(" %3d%%", int(value*100))
+
+	x := area.X
+	if p.Label != "" {
+		_ = r.DrawText(p.Label+" ", x, area.Y, r.Color(render.RoleText))
+		x += render.StringWidth(p.Label) + 1
+	}
+
+	barWidth := area.X + area.Width - x - 2 - len(percent)
+	if barWidth <= 0 {
+		return
+	}
+	filled := int(value*float64(barWidth) + 0.5)
+
+	_ = r.DrawChar('[', x, area.Y, r.Color(render.RoleText))
+	_ = r.DrawRect(x+1, area.Y, filled, 1, render.FullBlock, r.Color(roleOr(p.Role, render.RoleAccent)))
+	_ = r.DrawRect(x+1+filled, area.Y, barWidth-filled, 1, render.LightShade, r.Color(render.RoleText))
+	_ = r.DrawChar(']', x+1+barWidth, area.Y, r.Color(render.RoleText))
+	_ = r.DrawText(percent, x+2+barWidth, area.Y, r.Color(render.RoleText))
+}
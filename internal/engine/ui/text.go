@@ -0,0 +1,123 @@
+package ui
+
+import (
+	"github.com/kuhree/gg/internal/engine/render"
+)
+
+// Label is a single line of text in a theme role
+type Label struct {
+	Text  string
+	Role  string
+	Align render.Align
+	// Blink hides the label while off, nil never blinks
+	Blink *Blink
+}
+
+// Draw draws the label on the first row of area, truncated to fit
+func (l *Label) Draw(r *render.Renderer, area render.Rect) {
+	if !l.Blink.On() || area.Empty() {
+		return
+	}
+	_ = r.DrawTextAligned(l.Text, area.X, area.Y, area.Width, l.Align, r.Color(roleOr(l.Role, render.RoleText)))
+}
+
+// List is a heading followed by lines of text, e.g. a list of controls
+type List struct {
+	Heading string
+	Items   []string
+	// Spacing is the number of rows from one line to the next, at least 1
+	Spacing int
+}
+
+// Height returns the number of rows the list needs
+func (l *List) Height() int {
+	lines := len(l.Items)
+	if l.Heading != "" {
+		lines++
+	}
+	if lines == 0 {
+		return 0
+	}
+	return (lines-1)*max(l.Spacing, 1) + 1
+}
+
+// Draw draws the heading and items from the top of area
+func (l *List) Draw(r *render.Renderer, area render.Rect) {
+	spacing := max(l.Spacing, 1)
+	y := area.Y
+	if l.Heading != "" {
+		_ = r.DrawTextAligned(l.Heading, area.X, y, area.Width, render.AlignLeft, r.Color(render.RoleHeading))
+		y += spacing
+	}
+	for _, item := range l.Items {
+		if y >= area.Y+area.Height {
+			return
+		}
+		_ = r.DrawTextAligned(item, area.X, y, area.Width, render.AlignLeft, r.Color(render.RoleText))
+		y += spacing
+	}
+}
+
+// defaultBannerFonts are tried in order by a Banner without Fonts. Both are
+// four rows tall, so menus keep their layout whichever one fits.
+var defaultBannerFonts = []*render.BannerFont{render.HalfFont, render.QuadrantFont}
+
+// Banner is large title text. It uses the first of Fonts that fits the
+// area's width and falls back to a plain line of text when none does.
+type Banner struct {
+	Text  string
+	Fonts []*render.BannerFont
+	// Roles color the rows from top to bottom, defaulting to title then accent
+	Roles []string
+	Align render.Align
+}
+
+// font returns the font to draw in within width cells, nil for plain text
+func (b *Banner) font(width int) *render.BannerFont {
+	fonts := b.Fonts
+	if fonts == nil {
+		fonts = defaultBannerFonts
+	}
+	return render.FitBanner(b.Text, width, fonts...)
+}
+
+// Height returns the number of rows the banner needs within width cells
+func (b *Banner) Height(width int) int {
+	font := b.font(width)
+	if font == nil {
+		return 1
+	}
+	_, height := font.Measure(b.Text)
+	return height
+}
+
+// Draw draws the banner from the top of area
+func (b *Banner) Draw(r *render.Renderer, area render.Rect) {
+	if area.Empty() {
+		return
+	}
+
+	roles := b.Roles
+	if len(roles) == 0 {
+		roles = []string{render.RoleTitle, render.RoleAccent}
+	}
+	gradient := make(render.Gradient, len(roles))
+	for i, role := range roles {
+		gradient[i] = r.Color(role)
+	}
+
+	font := b.font(area.Width)
+	if font == nil {
+		_ = r.DrawTextAligned(b.Text, area.X, area.Y, area.Width, b.Align, gradient[0])
+		return
+	}
+	_ = r.DrawBannerAligned(b.Text, area.X, area.Y, area.Width, b.Align, font, gradient)
+}
+
+// roleOr returns role, or fallback if role is empty
+func roleOr(role, fallback string) string {
+	if role == "" {
+		return fallback
+	}
+	return role
+}
@@ -0,0 +1,128 @@
+// Package ui provides widgets for menus, text input, tables and dialogs drawn
+// with a render.Renderer. Widgets draw into a render.Rect and take their
+// colors from the renderer's theme roles.
+package ui
+
+import (
+	"github.com/kuhree/gg/internal/engine/core"
+	"github.com/kuhree/gg/internal/engine/render"
+	"github.com/kuhree/gg/internal/engine/timing"
+)
+
+// Widget draws itself into an area of the screen
+type Widget interface {
+	Draw(r *render.Renderer, area render.Rect)
+}
+
+// Interactive is a widget that takes keyboard input while focused.
+// HandleInput reports whether the input was used.
+type Interactive interface {
+	Widget
+	HandleInput(input core.InputEvent) (bool, error)
+	SetFocused(focused bool)
+	Focused() bool
+}
+
+// Focusable implements the focus half of Interactive for embedding
+type Focusable struct {
+	focused bool
+}
+
+// SetFocused focuses or blurs the widget
+func (f *Focusable) SetFocused(focused bool) {
+	f.focused = focused
+}
+
+// Focused reports whether the widget has focus
+func (f *Focusable) Focused() bool {
+	return f.focused
+}
+
+// FocusGroup passes input to one of several widgets, Tab moves focus to the next
+type FocusGroup struct {
+	widgets []Interactive
+	current int
+}
+
+// NewFocusGroup creates a group with the first widget focused
+func NewFocusGroup(widgets ...Interactive) *FocusGroup {
+	g := &FocusGroup{widgets: widgets}
+	g.Focus(0)
+	return g
+}
+
+// Focus moves focus to the widget at index i
+func (g *FocusGroup) Focus(i int) {
+	if len(g.widgets) == 0 {
+		return
+	}
+
+	g.current = (i + len(g.widgets)) % len(g.widgets)
+	for j, widget := range g.widgets {
+		widget.SetFocused(j == g.current)
+	}
+}
+
+// Current returns the focused widget, or nil for an empty group
+func (g *FocusGroup) Current() Interactive {
+	if len(g.widgets) == 0 {
+		return nil
+	}
+	return g.widgets[g.current]
+}
+
+// HandleInput sends input to the focused widget, cycling focus on Tab
+func (g *FocusGroup) HandleInput(input core.InputEvent) (bool, error) {
+	current := g.Current()
+	if current == nil {
+		return false, nil
+	}
+
+	if handled, err := current.HandleInput(input); handled || err != nil {
+		return handled, err
+	}
+	if input.Rune == core.KeyTab {
+		g.Focus(g.current + 1)
+		return true, nil
+	}
+	return false, nil
+}
+
+// Blink toggles on and off at a fixed interval, for prompts and cursors
+type Blink struct {
+	Interval float64
+	timer    timing.Timer
+	off      bool
+}
+
+// NewBlink creates a blink that starts on
+func NewBlink(interval float64) *Blink {
+	return &Blink{Interval: interval}
+}
+
+// Update advances the blink timer
+func (b *Blink) Update(dt float64) {
+	if b == nil {
+		return
+	}
+
+	b.timer.Duration, b.timer.Repeat = b.Interval, true
+	if b.Interval > 0 && b.timer.Update(dt) {
+		b.off = !b.off
+	}
+}
+
+// On reports whether the blinking element is visible. A nil Blink is always on.
+func (b *Blink) On() bool {
+	return b == nil || !b.off
+}
+
+// Reset turns the blink back on and restarts its timer
+func (b *Blink) Reset() {
+	if b == nil {
+		return
+	}
+
+	b.timer.Reset()
+	b.off = false
+}